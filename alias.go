@@ -0,0 +1,97 @@
+package comver
+
+import (
+	"regexp"
+	"strings"
+)
+
+const errInvalidAliasString stringError = "invalid alias string"
+
+var aliasRegexp = regexp.MustCompile(`^([^,\s]+)\s+as\s+([^,\s]+)$`)
+
+// Alias represents a composer [inline alias], e.g.: 'dev-main as 1.0.0', which
+// is the actual version aliased as another version.
+//
+// [inline alias]: https://getcomposer.org/doc/articles/aliases.md#require-inline-alias
+type Alias struct {
+	actual  Version
+	aliased Version
+}
+
+// NewAlias returns an [Alias] of the actual version aliased as the given
+// aliased version.
+func NewAlias(actual, aliased Version) Alias {
+	return Alias{
+		actual:  actual,
+		aliased: aliased,
+	}
+}
+
+// ParseAlias parses a given inline alias string, e.g.: 'dev-main as 1.0.0',
+// into an [Alias] or return an error if unable to parse the alias string.
+// Both sides of the alias must be valid versions, see [Parse].
+func ParseAlias(s string) (Alias, error) {
+	m := aliasRegexp.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return Alias{}, &ParseError{s, errInvalidAliasString}
+	}
+
+	actual, err := Parse(m[1])
+	if err != nil {
+		return Alias{}, &ParseError{s, err}
+	}
+
+	aliased, err := Parse(m[2])
+	if err != nil {
+		return Alias{}, &ParseError{s, err}
+	}
+
+	return NewAlias(actual, aliased), nil
+}
+
+// MustParseAlias is like [ParseAlias] but panics if the alias string cannot be
+// parsed.
+func MustParseAlias(s string) Alias {
+	a, err := ParseAlias(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return a
+}
+
+// Actual returns the actual version, e.g.: 'dev-main' for 'dev-main as 1.0.0'.
+func (a Alias) Actual() Version {
+	return a.actual
+}
+
+// Aliased returns the aliased version, e.g.: '1.0.0' for 'dev-main as 1.0.0'.
+func (a Alias) Aliased() Version {
+	return a.aliased
+}
+
+func (a Alias) String() string {
+	return a.actual.String() + " as " + a.aliased.String()
+}
+
+// Satisfies reports whether the alias satisfies the [Constrainter].
+//
+// Same as composer, an alias satisfies constraints for either the actual or
+// the aliased version, e.g.: 'dev-main as 1.0.0' satisfies both 'dev-main'
+// and '^1.0'.
+func (a Alias) Satisfies(c Constrainter) bool {
+	return c.Check(a.actual) || c.Check(a.aliased)
+}
+
+// stripAlias returns the constraint string with the inline alias removed,
+// i.e.: only the actual version is kept. Constraint strings without aliases
+// are returned as is.
+//
+// Same as composer, the aliased version is not validated.
+func stripAlias(s string) string {
+	if m := aliasRegexp.FindStringSubmatch(s); m != nil {
+		return m[1]
+	}
+
+	return s
+}
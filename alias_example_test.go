@@ -0,0 +1,31 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleParseAlias() {
+	a, _ := comver.ParseAlias("dev-main as 1.0.0")
+
+	fmt.Println(a.Actual())
+	fmt.Println(a.Aliased())
+
+	// Output:
+	// dev-main
+	// 1.0.0.0
+}
+
+func ExampleAlias_Satisfies() {
+	a := comver.MustParseAlias("dev-main as 1.0.0")
+
+	fmt.Println(a.Satisfies(comver.MustParseConstraint("^1.0")))
+	fmt.Println(a.Satisfies(comver.MustParseConstraint("dev-main")))
+	fmt.Println(a.Satisfies(comver.MustParseConstraint("^2.0")))
+
+	// Output:
+	// true
+	// true
+	// false
+}
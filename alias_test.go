@@ -0,0 +1,119 @@
+package comver
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseAlias(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s           string
+		wantActual  string
+		wantAliased string
+	}{
+		{"dev-main as 1.0.0", "dev-main", "1.0.0.0"},
+		{"dev-feature/foo as 1.0.x-dev", "dev-feature/foo", "1.0.9999999.9999999-dev"},
+		{"1.x-dev as 1.2.3", "1.9999999.9999999.9999999-dev", "1.2.3.0"},
+		{"  dev-main   as   2.0.0-beta1  ", "dev-main", "2.0.0.0-beta1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseAlias(tt.s)
+			if err != nil {
+				t.Fatalf("ParseAlias(%q) error = %v", tt.s, err)
+			}
+
+			if gotActual := got.Actual().String(); gotActual != tt.wantActual {
+				t.Errorf("ParseAlias(%q).Actual() = %q, want %q", tt.s, gotActual, tt.wantActual)
+			}
+
+			if gotAliased := got.Aliased().String(); gotAliased != tt.wantAliased {
+				t.Errorf("ParseAlias(%q).Aliased() = %q, want %q", tt.s, gotAliased, tt.wantAliased)
+			}
+		})
+	}
+}
+
+func TestParseAlias_ParseError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s       string
+		wantErr error
+	}{
+		{"", errInvalidAliasString},
+		{"dev-main", errInvalidAliasString},
+		{"dev-main as", errInvalidAliasString},
+		{"as 1.0.0", errInvalidAliasString},
+		{"dev-main as 1.0.0 as 2.0.0", errInvalidAliasString},
+		{"dev-main as ^2.0", errInvalidVersionString},
+		{"foo as 1.0.0", errInvalidVersionString},
+		{"dev-main as 1.0.0@dev", errNotFixedVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseAlias(tt.s)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseAlias(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+
+			var wantParseError *ParseError
+			if !errors.As(err, &wantParseError) {
+				t.Fatalf("ParseAlias(%q) error = %#v, wantErr %#v", tt.s, err, wantParseError)
+			}
+
+			if wantParseError.Original() != tt.s {
+				t.Errorf("ParseAlias(%q) error.Original() = %v, want %v", tt.s, wantParseError.Original(), tt.s)
+			}
+		})
+	}
+}
+
+func TestAlias_Satisfies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a    string
+		c    string
+		want bool
+	}{
+		{"dev-main as 1.0.0", "dev-main", true},
+		{"dev-main as 1.0.0", "^1.0", true},
+		{"dev-main as 1.0.0", "1.0.0", true},
+		{"dev-main as 1.0.0", "^2.0", false},
+		{"dev-main as 1.0.0", "dev-foo", false},
+		{"dev-main as 1.0.x-dev", "~1.0.0", true},
+		{"dev-main as 1.0.x-dev", "1.0.*", true},
+		{"1.x-dev as 1.2.3", "^1.0", true},
+		{"1.x-dev as 2.0.0", "^2.0", true},
+		{"1.x-dev as 2.0.0", "^3.0", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.c, func(t *testing.T) {
+			t.Parallel()
+
+			a := MustParseAlias(tt.a)
+
+			if got := a.Satisfies(MustParseConstraint(tt.c)); got != tt.want {
+				t.Errorf("Alias(%q).Satisfies(%q) = %v, want %v", tt.a, tt.c, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlias_String(t *testing.T) {
+	t.Parallel()
+
+	a := NewAlias(MustParse("dev-main"), MustParse("1.0.0"))
+
+	if got, want := a.String(), "dev-main as 1.0.0.0"; got != want {
+		t.Errorf("Alias.String() = %q, want %q", got, want)
+	}
+}
@@ -59,7 +59,7 @@ func And(es ...Endless) (CeilingFloorConstrainter, error) { //nolint:cyclop,iret
 		return NewExactConstraint(*floor.floor().version), nil
 	}
 
-	return interval{
+	return Interval{
 		upper: ceiling,
 		lower: floor,
 	}, nil
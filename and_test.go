@@ -298,7 +298,7 @@ func TestAnd(t *testing.T) {
 				NewLessThan(MustParse("4")),
 				NewGreaterThan(MustParse("1")),
 			},
-			want: interval{
+			want: Interval{
 				upper: NewLessThan(MustParse("4")),
 				lower: NewGreaterThan(MustParse("1")),
 			},
@@ -313,7 +313,7 @@ func TestAnd(t *testing.T) {
 				NewLessThan(MustParse("3")),
 				NewGreaterThan(MustParse("1")),
 			},
-			want: interval{
+			want: Interval{
 				upper: NewLessThan(MustParse("3")),
 				lower: NewGreaterThan(MustParse("1")),
 			},
@@ -327,7 +327,7 @@ func TestAnd(t *testing.T) {
 				NewLessThanOrEqualTo(MustParse("3")),
 				NewGreaterThan(MustParse("1")),
 			},
-			want: interval{
+			want: Interval{
 				upper: NewLessThanOrEqualTo(MustParse("3")),
 				lower: NewGreaterThan(MustParse("1")),
 			},
@@ -342,7 +342,7 @@ func TestAnd(t *testing.T) {
 				NewGreaterThan(MustParse("1")),
 				NewGreaterThanOrEqualTo(MustParse("1")),
 			},
-			want: interval{
+			want: Interval{
 				upper: NewLessThan(MustParse("4")),
 				lower: NewGreaterThan(MustParse("2")),
 			},
@@ -356,7 +356,7 @@ func TestAnd(t *testing.T) {
 				NewGreaterThan(MustParse("1")),
 				NewGreaterThanOrEqualTo(MustParse("1")),
 			},
-			want: interval{
+			want: Interval{
 				upper: NewLessThan(MustParse("4")),
 				lower: NewGreaterThanOrEqualTo(MustParse("2")),
 			},
@@ -374,7 +374,7 @@ func TestAnd(t *testing.T) {
 				NewGreaterThan(MustParse("1")),
 				NewGreaterThanOrEqualTo(MustParse("1")),
 			},
-			want: interval{
+			want: Interval{
 				upper: NewLessThan(MustParse("3")),
 				lower: NewGreaterThan(MustParse("2")),
 			},
@@ -393,7 +393,7 @@ func TestAnd(t *testing.T) {
 				NewGreaterThanOrEqualTo(MustParse("1")),
 				NewMatchAll(),
 			},
-			want: interval{
+			want: Interval{
 				upper: NewLessThan(MustParse("3")),
 				lower: NewGreaterThan(MustParse("2")),
 			},
@@ -0,0 +1,44 @@
+package comver
+
+// Bound represents a read-only view of either the lower or the upper bound of
+// a [CeilingFloorConstrainter], e.g.: the lower bound of '>=1.0 <2.0' is
+// inclusive 1.0 while its upper bound is exclusive 2.0.
+// The zero value for Bound is a bounded, exclusive v0.0.0.0.
+type Bound struct {
+	version   Version
+	inclusive bool
+	unbounded bool
+}
+
+func newBound(e Endless) Bound {
+	if e.matchAll() {
+		return Bound{ //nolint:exhaustruct
+			unbounded: true,
+		}
+	}
+
+	return Bound{
+		version:   *e.version,
+		inclusive: e.inclusive(),
+		unbounded: false,
+	}
+}
+
+// Version returns the version of the bound.
+// The zero value [Version] is returned when the bound is unbounded.
+func (b Bound) Version() Version {
+	return b.version
+}
+
+// Inclusive reports whether the version of the bound satisfies the
+// constraint, e.g.: true for both bounds of '>=1.0 <=2.0'.
+// It is always false when the bound is unbounded.
+func (b Bound) Inclusive() bool {
+	return b.inclusive
+}
+
+// Unbounded reports whether there is no such bound, e.g.: true for the upper
+// bound of '>=1.0' and both bounds of '*'.
+func (b Bound) Unbounded() bool {
+	return b.unbounded
+}
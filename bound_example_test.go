@@ -0,0 +1,35 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleOr_Intervals() {
+	c, _ := comver.ParseConstraint("<1.0 || ^2.0")
+	o, _ := c.(comver.Or)
+
+	for i := range o.Intervals() {
+		lower, upper := i.Lower(), i.Upper()
+
+		switch {
+		case lower.Unbounded():
+			fmt.Print("(-inf, ")
+		case lower.Inclusive():
+			fmt.Printf("[%s, ", lower.Version().Short())
+		default:
+			fmt.Printf("(%s, ", lower.Version().Short())
+		}
+
+		if upper.Inclusive() {
+			fmt.Printf("%s]\n", upper.Version().Short())
+		} else {
+			fmt.Printf("%s)\n", upper.Version().Short())
+		}
+	}
+
+	// Output:
+	// (-inf, 1-dev)
+	// [2-dev, 3-dev)
+}
@@ -0,0 +1,149 @@
+package comver
+
+import "testing"
+
+func TestCeilingFloorConstrainter_LowerUpper(t *testing.T) {
+	t.Parallel()
+
+	type bound struct {
+		version   string
+		inclusive bool
+		unbounded bool
+	}
+
+	tests := []struct {
+		name      string
+		c         CeilingFloorConstrainter
+		wantLower bound
+		wantUpper bound
+	}{
+		{
+			name:      "match_all",
+			c:         NewMatchAll(),
+			wantLower: bound{"", false, true},
+			wantUpper: bound{"", false, true},
+		},
+		{
+			name:      "greater_than_or_equal_to",
+			c:         NewGreaterThanOrEqualTo(MustParse("1")),
+			wantLower: bound{"1", true, false},
+			wantUpper: bound{"", false, true},
+		},
+		{
+			name:      "greater_than",
+			c:         NewGreaterThan(MustParse("1")),
+			wantLower: bound{"1", false, false},
+			wantUpper: bound{"", false, true},
+		},
+		{
+			name:      "less_than",
+			c:         NewLessThan(MustParse("2")),
+			wantLower: bound{"", false, true},
+			wantUpper: bound{"2", false, false},
+		},
+		{
+			name:      "less_than_or_equal_to",
+			c:         NewLessThanOrEqualTo(MustParse("2")),
+			wantLower: bound{"", false, true},
+			wantUpper: bound{"2", true, false},
+		},
+		{
+			name:      "exact",
+			c:         NewExactConstraint(MustParse("1.5")),
+			wantLower: bound{"1.5", true, false},
+			wantUpper: bound{"1.5", true, false},
+		},
+		{
+			name:      "interval",
+			c:         MustAnd(NewGreaterThanOrEqualTo(MustParse("1")), NewLessThan(MustParse("2"))),
+			wantLower: bound{"1", true, false},
+			wantUpper: bound{"2", false, false},
+		},
+		{
+			name:      "interval_2",
+			c:         MustAnd(NewGreaterThan(MustParse("1")), NewLessThanOrEqualTo(MustParse("2-beta"))),
+			wantLower: bound{"1", false, false},
+			wantUpper: bound{"2-beta", true, false},
+		},
+		{
+			name:      "branch",
+			c:         NewExactConstraint(MustParse("dev-master")),
+			wantLower: bound{"dev-master", true, false},
+			wantUpper: bound{"dev-master", true, false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, b := range []struct {
+				name string
+				got  Bound
+				want bound
+			}{
+				{"Lower", tt.c.Lower(), tt.wantLower},
+				{"Upper", tt.c.Upper(), tt.wantUpper},
+			} {
+				var gotVersion string
+				if !b.got.Unbounded() {
+					gotVersion = b.got.Version().Short()
+				}
+
+				if gotVersion != b.want.version {
+					t.Errorf("%q.%s().Version() = %q, want %q", tt.c, b.name, gotVersion, b.want.version)
+				}
+
+				if got := b.got.Inclusive(); got != b.want.inclusive {
+					t.Errorf("%q.%s().Inclusive() = %v, want %v", tt.c, b.name, got, b.want.inclusive)
+				}
+
+				if got := b.got.Unbounded(); got != b.want.unbounded {
+					t.Errorf("%q.%s().Unbounded() = %v, want %v", tt.c, b.name, got, b.want.unbounded)
+				}
+			}
+		})
+	}
+}
+
+func TestOr_Intervals(t *testing.T) {
+	t.Parallel()
+
+	o := Or{
+		NewLessThan(MustParse("1")),
+		NewExactConstraint(MustParse("1.5")),
+		MustAnd(NewGreaterThanOrEqualTo(MustParse("2")), NewLessThan(MustParse("3"))),
+	}
+
+	var got []string
+	for c := range o.Intervals() {
+		got = append(got, c.String())
+	}
+
+	want := []string{"<1", "1.5", ">=2 <3"}
+
+	if len(got) != len(want) {
+		t.Fatalf("Or.Intervals() = %q, want %q", got, want)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Or.Intervals()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	// stop early
+	var n int
+	for range o.Intervals() {
+		n++
+
+		break
+	}
+
+	if n != 1 {
+		t.Errorf("Or.Intervals() yielded %d after break, want 1", n)
+	}
+
+	for c := range (Or{}).Intervals() {
+		t.Errorf("Or{}.Intervals() yielded %q, want none", c)
+	}
+}
@@ -0,0 +1,116 @@
+package comver
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	errBranchBump    stringError = "dev branches could not be bumped"
+	errNotPreRelease stringError = "not a pre-release"
+)
+
+// NextMajor returns the next major version, e.g.: '2.0.0.0' for '1.2.3.4'.
+//
+// Same as [semantic versioning], a pre-release of a major version is bumped
+// to its release, e.g.: '2.0.0.0' for '2.0.0.0-RC1'. It returns an error for
+// named dev branches, numeric dev branches (e.g. '1.x-dev'), or if the result
+// is not a valid version, see [NewVersion].
+//
+// [semantic versioning]: https://semver.org/#spec-item-9
+func (v Version) NextMajor() (Version, error) {
+	return v.next(1)
+}
+
+// NextMinor returns the next minor version, e.g.: '1.3.0.0' for '1.2.3.4'.
+// A pre-release of a minor version is bumped to its release, e.g.: '1.3.0.0'
+// for '1.3.0.0-beta2'. See [Version.NextMajor].
+func (v Version) NextMinor() (Version, error) {
+	return v.next(2) //nolint:mnd
+}
+
+// NextPatch returns the next patch version, e.g.: '1.2.4.0' for '1.2.3.4'.
+// A pre-release of a patch version is bumped to its release, e.g.: '1.2.3.0'
+// for '1.2.3.0-RC1'. See [Version.NextMajor].
+func (v Version) NextPatch() (Version, error) {
+	return v.next(3) //nolint:mnd
+}
+
+// NextTweak returns the next tweak version, e.g.: '1.2.3.5' for '1.2.3.4'.
+// A pre-release is bumped to its release, e.g.: '1.2.3.4' for
+// '1.2.3.4-alpha'. See [Version.NextMajor].
+//
+// CalVer versions have no tweak, thus an error is returned for them.
+func (v Version) NextTweak() (Version, error) {
+	return v.next(4) //nolint:mnd
+}
+
+// NextPreRelease returns the next pre-release of the same modifier by
+// incrementing its last number, e.g.: '1.2.3.0-beta3' for '1.2.3.0-beta2' and
+// '1.2.3.0-alpha1' for '1.2.3.0-alpha'. Dev suffixes are dropped instead,
+// e.g.: '1.2.3.0-RC1' for '1.2.3.0-RC1-dev'.
+//
+// Same as [Version.PreRelease], patch modifiers are counted, e.g.:
+// '1.2.3.0-patch2' for '1.2.3.0-patch1'. It returns an error for stable
+// versions, dev versions without modifiers (e.g. '1.2.3.0-dev') and named dev
+// branches. Use [Version.NextPatch] and alike to release a pre-release.
+func (v Version) NextPreRelease() (Version, error) {
+	switch {
+	case v.isBranch():
+		return Version{}, errBranchBump
+	case v.modifier == modifierStable, v.modifier == modifierDev:
+		return Version{}, errNotPreRelease
+	case v.devSuffix:
+		v.devSuffix = false
+		v.metadata = ""
+		v.original = ""
+
+		return v, nil
+	}
+
+	// pre-release numbers are digits separated by '.' or '-', e.g.: '1.2'
+	i := strings.LastIndexFunc(v.preRelease, func(r rune) bool { return r < '0' || r > '9' }) + 1
+
+	var n uint64
+
+	if s := v.preRelease[i:]; s != "" {
+		var err error
+		if n, err = strconv.ParseUint(s, 10, 64); err != nil || n == math.MaxUint64 {
+			return Version{}, errInvalidVersionString
+		}
+	}
+
+	v.preRelease = v.preRelease[:i] + strconv.FormatUint(n+1, 10)
+	v.metadata = ""
+	v.original = ""
+
+	return v, nil
+}
+
+// next returns the next version with the numeric component at the given
+// position (1 for major, 4 for tweak) bumped, or the release of a pre-release
+// when all less significant components are zero.
+func (v Version) next(position int) (Version, error) {
+	if v.isBranch() || v.isNumericBranch() {
+		return Version{}, errBranchBump
+	}
+
+	w := v.bump(position)
+
+	if v.modifier < modifierStable && v.truncate(position).Compare(v.truncate(4)) == 0 { //nolint:mnd
+		w = v.truncate(4) //nolint:mnd
+		w.precision = v.precision
+	}
+
+	// overflowed components wrap around to zero, i.e.: not greater than v
+	if !validMajor(w.major) || w.Compare(v) <= 0 {
+		return Version{}, errInvalidVersionString
+	}
+
+	if w.major >= 1000_00 && w.tweak != 0 {
+		return Version{}, errDateVersionWithFourBits
+	}
+
+	return w, nil
+}
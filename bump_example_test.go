@@ -0,0 +1,45 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleVersion_NextMinor() {
+	v := comver.MustParse("1.2.3")
+
+	minor, _ := v.NextMinor()
+	patch, _ := v.NextPatch()
+
+	fmt.Println(minor.Short())
+	fmt.Println(patch.Short())
+
+	// Output:
+	// 1.3
+	// 1.2.4
+}
+
+func ExampleVersion_NextPatch_preRelease() {
+	v := comver.MustParse("1.2.3-RC1")
+
+	w, _ := v.NextPatch()
+
+	fmt.Println(w.Short())
+
+	// Output:
+	// 1.2.3
+}
+
+func ExampleVersion_NextPreRelease() {
+	v := comver.MustParse("1.2.3-beta2")
+
+	w, _ := v.NextPreRelease()
+
+	fmt.Println(w.Short())
+	fmt.Println(w.Compare(v))
+
+	// Output:
+	// 1.2.3-beta3
+	// 1
+}
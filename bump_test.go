@@ -0,0 +1,170 @@
+package comver
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestVersion_Next(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v         string
+		wantMajor string
+		wantMinor string
+		wantPatch string
+		wantTweak string
+	}{
+		{"1.2.3.4", "2.0.0.0", "1.3.0.0", "1.2.4.0", "1.2.3.5"},
+		{"0.0.0", "1.0.0.0", "0.1.0.0", "0.0.1.0", "0.0.0.1"},
+		{"1.2.3.4-beta2", "2.0.0.0", "1.3.0.0", "1.2.4.0", "1.2.3.4"},
+		{"1.2.3-RC1", "2.0.0.0", "1.3.0.0", "1.2.3.0", "1.2.3.0"},
+		{"1.2.0-alpha", "2.0.0.0", "1.2.0.0", "1.2.0.0", "1.2.0.0"},
+		{"2.0.0-RC1-dev", "2.0.0.0", "2.0.0.0", "2.0.0.0", "2.0.0.0"},
+		{"2.0.0-dev", "2.0.0.0", "2.0.0.0", "2.0.0.0", "2.0.0.0"},
+		{"1.2.3-patch1", "2.0.0.0", "1.3.0.0", "1.2.4.0", "1.2.3.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			v := MustParse(tt.v)
+
+			for name, next := range map[string]struct {
+				f    func() (Version, error)
+				want string
+			}{
+				"NextMajor": {v.NextMajor, tt.wantMajor},
+				"NextMinor": {v.NextMinor, tt.wantMinor},
+				"NextPatch": {v.NextPatch, tt.wantPatch},
+				"NextTweak": {v.NextTweak, tt.wantTweak},
+			} {
+				got, err := next.f()
+				if err != nil {
+					t.Fatalf("%s() error = %v, want nil", name, err)
+				}
+
+				if got.String() != next.want {
+					t.Errorf("%s() got = %q, want %q", name, got, next.want)
+				}
+
+				if got.Compare(v) != +1 {
+					t.Errorf("%s() got = %q, not greater than %q", name, got, v)
+				}
+
+				if got.Original() != "" {
+					t.Errorf("%s().Original() = %q, want empty", name, got.Original())
+				}
+			}
+		})
+	}
+}
+
+func TestVersion_Next_error(t *testing.T) {
+	t.Parallel()
+
+	largest := Version{major: 1, minor: 18446744073709551615, patch: 18446744073709551615, tweak: 18446744073709551615} //nolint:exhaustruct,lll
+
+	tests := []struct {
+		name    string
+		f       func() (Version, error)
+		wantErr error
+	}{
+		{"branch major", MustParse("dev-master").NextMajor, errBranchBump},
+		{"branch tweak", MustParse("dev-master").NextTweak, errBranchBump},
+		{"numeric branch major", MustParse("1.x-dev").NextMajor, errBranchBump},
+		{"numeric branch minor", MustParse("1.x-dev").NextMinor, errBranchBump},
+		{"numeric branch patch", MustParse("1.2.x-dev").NextPatch, errBranchBump},
+		{"numeric branch tweak", MustParse("1.2.x-dev").NextTweak, errBranchBump},
+		{"date numeric branch major", MustParse("20240102.x-dev").NextMajor, errBranchBump},
+		{"date tweak", MustParse("20240102").NextTweak, errDateVersionWithFourBits},
+		{"date major overflow", MustParse("99999999").NextMajor, errInvalidVersionString},
+		{"major overflow", Version{major: 999999999999}.NextMajor, errInvalidVersionString}, //nolint:exhaustruct
+		{"minor overflow", largest.NextMinor, errInvalidVersionString},
+		{"patch overflow", largest.NextPatch, errInvalidVersionString},
+		{"tweak overflow", largest.NextTweak, errInvalidVersionString},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.f()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got = %q, error = %v, wantErr %v", got, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVersion_Next_date(t *testing.T) {
+	t.Parallel()
+
+	v := MustParse("20240102")
+
+	got, err := v.NextPatch()
+	if err != nil {
+		t.Fatalf("NextPatch() error = %v, want nil", err)
+	}
+
+	if want := "20240102.0.1.0"; got.String() != want {
+		t.Errorf("NextPatch() got = %q, want %q", got, want)
+	}
+
+	got, err = v.NextMajor()
+	if err != nil {
+		t.Fatalf("NextMajor() error = %v, want nil", err)
+	}
+
+	if want := "20240103.0.0.0"; got.String() != want {
+		t.Errorf("NextMajor() got = %q, want %q", got, want)
+	}
+}
+
+func TestVersion_NextPreRelease(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v       string
+		want    string
+		wantErr error
+	}{
+		{"1.2.3-beta2", "1.2.3.0-beta3", nil},
+		{"1.2.3-beta", "1.2.3.0-beta1", nil},
+		{"1.2.3-b.9", "1.2.3.0-beta10", nil},
+		{"1.2.3-RC1", "1.2.3.0-RC2", nil},
+		{"1.2.3-alpha.1.2", "1.2.3.0-alpha1.3", nil},
+		{"1.2.3-alpha1-2", "1.2.3.0-alpha1-3", nil},
+		{"1.2.3-patch1", "1.2.3.0-patch2", nil},
+		{"1.2.3-RC1-dev", "1.2.3.0-RC1", nil},
+		{"1.2.3-beta-dev", "1.2.3.0-beta", nil},
+		{"1.2.3", "", errNotPreRelease},
+		{"1.2.3-dev", "", errNotPreRelease},
+		{"1.x-dev", "", errNotPreRelease},
+		{"dev-master", "", errBranchBump},
+		{"1.2.3-beta18446744073709551615", "", errInvalidVersionString},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			v := MustParse(tt.v)
+
+			got, err := v.NextPreRelease()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NextPreRelease() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("NextPreRelease() got = %q, want %q", got, tt.want)
+			}
+
+			if got.Compare(v) != +1 {
+				t.Errorf("NextPreRelease() got = %q, not greater than %q", got, v)
+			}
+		})
+	}
+}
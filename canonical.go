@@ -0,0 +1,28 @@
+package comver
+
+// Equal reports whether a and b are satisfied by exactly the same versions,
+// e.g.: '>=1 <2 || >=1.5 <3' equals '>=1 <3'.
+//
+// It is computed symbolically, see [Implies]. Ranges bounded by named dev
+// branches, e.g.: '<dev-master' and '>master', equal [MatchNone]. Stability
+// flags are ignored.
+func Equal(a, b Constrainter) bool {
+	return Implies(a, b) && Implies(b, a)
+}
+
+// Canonical returns the unique [Compact]-ed representation of the given
+// [Constrainter], so that logically equivalent constraints have the same
+// [Constrainter.String], e.g.: both '>=1 <2 || >=1.5 <3' and '>=1 <3' result in
+// '>=1 <3'.
+//
+// Nested [AllOf] and [AnyOf] are normalized, see [Normalize]. [Or] are sorted
+// and compacted. [NotEqual] are kept, or combined into a sorted [AllOf] of
+// them, because they are satisfied by named dev branches but ranges are not.
+// Constraints which could never be satisfied, e.g.: the zero value [Or], are
+// converted into [MatchNone].
+//
+// When the given [Constrainter] is a [FlaggedConstraint], the result is a
+// [FlaggedConstraint] with the same flag.
+func Canonical(c Constrainter) Constrainter { //nolint:ireturn
+	return flagged(setOf(c).constraint(), c)
+}
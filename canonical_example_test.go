@@ -0,0 +1,27 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleEqual() {
+	a := comver.MustParseConstraint(">=1 <2 || >=1.5 <3")
+	b := comver.MustParseConstraint(">=1 <3")
+
+	fmt.Println(comver.Equal(a, b))
+	// Output: true
+}
+
+func ExampleCanonical() {
+	a := comver.MustParseConstraint("^3.0 || ^1.0 || !=1.5 ^1.0")
+	b := comver.MustParseConstraint(">=1 <2 || >=3 <4")
+
+	fmt.Println(comver.Canonical(a))
+	fmt.Println(comver.Canonical(b))
+
+	// Output:
+	// >=1-dev <2-dev || >=3-dev <4-dev
+	// >=1-dev <2-dev || >=3-dev <4-dev
+}
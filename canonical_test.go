@@ -0,0 +1,212 @@
+package comver
+
+import "testing"
+
+func TestEqual(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a    Constrainter
+		b    Constrainter
+		want bool
+	}{
+		{MustParseConstraint(">=1 <2 || >=1.5 <3"), MustParseConstraint(">=1 <3"), true},
+		{MustParseConstraint("^1.0 || ^2.0"), MustParseConstraint(">=1.0 <3.0"), true},
+		{MustParseConstraint("^1.0 || ^3.0"), MustParseConstraint(">=1.0 <4.0"), false},
+		{MustParseConstraint("~1.2"), MustParseConstraint("^1.2"), true},
+		{MustParseConstraint("~1.2.3"), MustParseConstraint("^1.2.3"), false},
+		{MustParseConstraint("1.2.*"), MustParseConstraint("~1.2.0"), true},
+		{MustParseConstraint("!=1.5"), NewNotEqual(MustParse("1.5")), true},
+		{MustParseConstraint("!=1.5"), Or{NewLessThan(MustParse("1.5")), NewGreaterThan(MustParse("1.5"))}, false},
+		{MustParseConstraint("!=1.5"), AllOf{NewNotEqual(MustParse("1.5"))}, true},
+		{MustParseConstraint("!=1.5"), MustParseConstraint("<1.5 || >1.5"), false},
+		{MustParseConstraint("<1.5 || >=1.5"), NewMatchAll(), false},
+		{MustParseConstraint("<1.5 || >=1.5"), numericMatchAll(), true},
+		{MustParseConstraint("!=1.5 || 1.5"), NewMatchAll(), true},
+		{NewMatchAll(), MustParseConstraint("*"), true},
+		{Or{}, MustParseConstraint("^1.0 ^2.0"), true},
+		{Or{}, NewMatchAll(), false},
+		{MustParseConstraint("^1.0@beta"), MustParseConstraint("^1.0"), true},
+		{MustParseConstraint("dev-master || dev-foo"), MustParseConstraint("dev-foo || dev-master"), true},
+		{MustParseConstraint("dev-master"), MustParseConstraint("dev-foo"), false},
+		{NewNotEqual(MustParse("dev-master")), NewNotEqual(MustParse("dev-master")), true},
+		{NewNotEqual(MustParse("dev-master")), NewNotEqual(MustParse("dev-foo")), false},
+		{NewNotEqual(MustParse("dev-master")), NewMatchAll(), false},
+		{NewLessThan(MustParse("dev-master")), NewMatchNone(), true},
+		{NewGreaterThan(MustParse("master")), MustParseConstraint(">dev-1"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a.String()+"/"+tt.b.String(), func(t *testing.T) {
+			t.Parallel()
+
+			if got := Equal(tt.a, tt.b); got != tt.want {
+				t.Errorf("Equal(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+
+			if got := Equal(tt.b, tt.a); got != tt.want {
+				t.Errorf("Equal(%q, %q) = %v, want %v", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEqual_reflexive(t *testing.T) {
+	t.Parallel()
+
+	tests := []Constrainter{
+		MustParseConstraint("^1.0 || ^3.0"),
+		MustParseConstraint("!=1.5"),
+		MustParseConstraint("!=1.5 !=dev-master"),
+		MustParseConstraint("dev-master || dev-foo"),
+		MustParseConstraint("<dev-master"),
+		MustParseConstraint(">master"),
+		MustParseConstraint(">dev-1"),
+		NewLessThan(MustParse("dev-master")),
+		NewGreaterThan(MustParse("master")),
+		NewGreaterThanOrEqualTo(MustParse("dev-1")),
+		NewMatchAll(),
+		NewMatchNone(),
+	}
+	for _, tt := range tests {
+		t.Run(tt.String(), func(t *testing.T) {
+			t.Parallel()
+
+			if got := Equal(tt, tt); !got {
+				t.Errorf("Equal(%q, %q) = %v, want %v", tt, tt, got, true)
+			}
+		})
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cs   []Constrainter
+		want string
+	}{
+		{
+			name: "overlapping",
+			cs: []Constrainter{
+				MustParseConstraint(">=1 <2 || >=1.5 <3"),
+				MustParseConstraint(">=1 <3"),
+				MustParseConstraint("^1.0 || ^2.0"),
+				MustParseConstraint(">=1 <1.5 || >=1.2 <2.5 || >=2.5 <3"),
+			},
+			want: ">=1-dev <3-dev",
+		},
+		{
+			name: "unsorted",
+			cs: []Constrainter{
+				MustParseConstraint("^3.0 || ^1.0"),
+				MustParseConstraint("^1.0 || ^3.0"),
+				Or{MustAnd(NewGreaterThanOrEqualTo(MustParse("3-dev")), NewLessThan(MustParse("4-dev"))), MustParseConstraint("^1.0").(CeilingFloorConstrainter)}, //nolint:forcetypeassert,lll
+			},
+			want: ">=1-dev <2-dev || >=3-dev <4-dev",
+		},
+		{
+			name: "not_equal",
+			cs: []Constrainter{
+				NewNotEqual(MustParse("1.5")),
+				MustParseConstraint("!=1.5"),
+				AnyOf{NewNotEqual(MustParse("1.5")), MustParseConstraint("^2")},
+			},
+			want: "!=1.5",
+		},
+		{
+			name: "not_equal_ranges",
+			cs: []Constrainter{
+				Or{NewGreaterThan(MustParse("1.5")), NewLessThan(MustParse("1.5"))},
+				AnyOf{NewLessThan(MustParse("1.5")), NewGreaterThan(MustParse("1.5"))},
+				AllOf{NewNotEqual(MustParse("1.5")), MustParseConstraint(">=0")},
+			},
+			want: "<1.5 || >1.5",
+		},
+		{
+			name: "match_all",
+			cs: []Constrainter{
+				NewMatchAll(),
+				MustParseConstraint("*"),
+				MustParseConstraint("!=1.5 || 1.5"),
+				AllOf{},
+			},
+			want: "*",
+		},
+		{
+			name: "numeric_match_all",
+			cs: []Constrainter{
+				MustParseConstraint("<1.5 || >=1.5"),
+				MustParseConstraint(">=0"),
+				Or{NewLessThan(MustParse("1")), NewGreaterThanOrEqualTo(MustParse("0.5"))},
+			},
+			want: ">=0-dev",
+		},
+		{
+			name: "match_none",
+			cs: []Constrainter{
+				Or{},
+				AnyOf{},
+				AllOf{MustParseConstraint("^1.0"), MustParseConstraint("^2.0")},
+			},
+			want: "[]",
+		},
+		{
+			name: "exact",
+			cs: []Constrainter{
+				NewExactConstraint(MustParse("1.5")),
+				MustParseConstraint("1.5.0.0"),
+				MustAnd(NewGreaterThanOrEqualTo(MustParse("1.5")), NewLessThanOrEqualTo(MustParse("1.5"))),
+				AllOf{NewGreaterThanOrEqualTo(MustParse("1.5")), NewLessThanOrEqualTo(MustParse("1.5"))},
+			},
+			want: "1.5",
+		},
+		{
+			name: "branches",
+			cs: []Constrainter{
+				MustParseConstraint("dev-master || dev-foo || ^1.0"),
+				MustParseConstraint("^1.0 || dev-foo || dev-master"),
+				AnyOf{MustParseConstraint("dev-master"), MustParseConstraint("^1.0 || dev-foo")},
+			},
+			want: ">=1-dev <2-dev || dev-foo || dev-master",
+		},
+		{
+			name: "branch_not_equals",
+			cs: []Constrainter{
+				AllOf{NewNotEqual(MustParse("dev-master")), NewNotEqual(MustParse("dev-foo"))},
+				AllOf{NewNotEqual(MustParse("dev-foo")), NewNotEqual(MustParse("dev-master"))},
+				AllOf{NewNotEqual(MustParse("dev-foo")), NewMatchAll(), NewNotEqual(MustParse("dev-master"))},
+			},
+			want: "!=dev-foo !=dev-master",
+		},
+		{
+			name: "flagged",
+			cs: []Constrainter{
+				MustParseConstraint("^1.0@beta"),
+				MustParseConstraint(">=1.0@beta <2.0"),
+			},
+			want: ">=1-dev <2-dev@beta",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, c := range tt.cs {
+				got := Canonical(c)
+
+				if gotString := got.String(); gotString != tt.want {
+					t.Errorf("Canonical(%q) = %q, want %q", c, gotString, tt.want)
+				}
+
+				if gotTwice := Canonical(got).String(); gotTwice != tt.want {
+					t.Errorf("Canonical(Canonical(%q)) = %q, want %q", c, gotTwice, tt.want)
+				}
+
+				if !Equal(got, c) {
+					t.Errorf("Equal(Canonical(%q), %q) = false, want true", c, c)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package comver
+
+// NewCaret returns a [CeilingFloorConstrainter] instance representing the
+// [caret version range] of the given [Version], e.g. '^1.2'; or return an
+// error if the [Version] is a named dev branch.
+//
+// Same as composer, only non-breaking updates are allowed. The first non-zero
+// numeric component is treated as the breaking one for pre-1.0 versions, e.g.:
+//   - '^1.2' means '>=1.2.0.0-dev <2.0.0.0-dev'
+//   - '^0.3' means '>=0.3.0.0-dev <0.4.0.0-dev'
+//   - '^0.0.3' means '>=0.0.3.0-dev <0.0.4.0-dev'
+//
+// Therefore, '^0.0' and '^0.0.0' are different constraints. For versions not
+// coming from [Parse], the number of numeric components in [Version.Short] is
+// used. Named dev branches, e.g. dev-master, have no ranges, same as
+// [NewHyphenRange].
+//
+// [caret version range]: https://getcomposer.org/doc/articles/versions.md#caret-version-range-
+func NewCaret(v Version) (CeilingFloorConstrainter, error) { //nolint:ireturn
+	if v.isBranch() {
+		var nilC CeilingFloorConstrainter
+
+		return nilC, errBranchInterval
+	}
+
+	var position int
+
+	switch parts := v.parts(); {
+	case v.major != 0 || parts < 2: //nolint:mnd
+		position = 1
+	case v.minor != 0 || parts < 3: //nolint:mnd
+		position = 2
+	default:
+		position = 3
+	}
+
+	return And(
+		NewGreaterThanOrEqualTo(v.lowestPreRelease()),
+		NewLessThan(v.bump(position).lowestPreRelease()),
+	)
+}
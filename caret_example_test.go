@@ -0,0 +1,22 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleNewCaret() {
+	major, _ := comver.NewCaret(comver.MustParse("1.2"))
+	minor, _ := comver.NewCaret(comver.MustParse("0.3"))
+	patch, _ := comver.NewCaret(comver.MustParse("0.0.3"))
+
+	fmt.Println(major)
+	fmt.Println(minor)
+	fmt.Println(patch)
+
+	// Output:
+	// >=1.2-dev <2-dev
+	// >=0.3-dev <0.4-dev
+	// >=0.0.3-dev <0.0.4-dev
+}
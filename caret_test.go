@@ -0,0 +1,97 @@
+package comver
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewCaret(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v    string
+		want string
+	}{
+		// taken from composer/semver VersionParserTest::caretConstraints()
+		// https://github.com/composer/semver/blob/1d09200268e7d1052ded8e5da9c73c96a63d18f5/tests/VersionParserTest.php
+		{"v1", ">=1-dev <2-dev"},
+		{"0", ">=0-dev <1-dev"},
+		{"0.0", ">=0-dev <0.1-dev"},
+		{"1.2", ">=1.2-dev <2-dev"},
+		{"1.2.3-beta.2", ">=1.2.3-beta2 <2-dev"},
+		{"1.2.3.4", ">=1.2.3.4-dev <2-dev"},
+		{"1.2.3", ">=1.2.3-dev <2-dev"},
+		{"0.2.3", ">=0.2.3-dev <0.3-dev"},
+		{"0.2", ">=0.2-dev <0.3-dev"},
+		{"0.2.0", ">=0.2-dev <0.3-dev"},
+		{"0.0.3", ">=0.0.3-dev <0.0.4-dev"},
+		{"0.0.3-alpha", ">=0.0.3-alpha <0.0.4-dev"},
+
+		// additional tests
+		{"0.3", ">=0.3-dev <0.4-dev"},
+		{"0.0.0", ">=0-dev <0.0.1-dev"},
+		{"0.0.0.1", ">=0.0.0.1-dev <0.0.1-dev"},
+		{"0.0.3-RC1", ">=0.0.3-RC1 <0.0.4-dev"},
+		{"2010.01.02", ">=2010.1.2-dev <2011-dev"},
+		{"99999999", ">=99999999-dev <100000000-dev"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewCaret(MustParse(tt.v))
+			if err != nil {
+				t.Fatalf("NewCaret(%v) error = %v", tt.v, err)
+			}
+
+			if gotString := got.String(); gotString != tt.want {
+				t.Errorf("NewCaret(%q).String() = %q, want %q", tt.v, gotString, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewCaret_zero(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		v    Version
+		want string
+	}{
+		{"zero", Version{}, ">=0-dev <1-dev"},
+		{"major", Version{major: 1}, ">=1-dev <2-dev"},
+		{"minor", Version{minor: 2}, ">=0.2-dev <0.3-dev"},
+		{"patch", Version{patch: 3}, ">=0.0.3-dev <0.0.4-dev"},
+		{"tweak", Version{tweak: 4}, ">=0.0.0.4-dev <0.0.1-dev"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewCaret(tt.v)
+			if err != nil {
+				t.Fatalf("NewCaret(%v) error = %v", tt.v, err)
+			}
+
+			if gotString := got.String(); gotString != tt.want {
+				t.Errorf("NewCaret(%v).String() = %q, want %q", tt.v, gotString, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewCaret_branch(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"dev-master", "dev-1.x"} {
+		t.Run(v, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewCaret(MustParse(v))
+			if !errors.Is(err, errBranchInterval) {
+				t.Errorf("NewCaret(%q) = %v, error = %v, wantErr %v", v, got, err, errBranchInterval)
+			}
+		})
+	}
+}
@@ -9,6 +9,10 @@ import (
 //
 // The returned [Constrainter] may or may be not be an [Or] instance.
 // When it is, Compact tries to return the shortest [Or] possible.
+//
+// Ranges are never satisfied by named dev branches, e.g.: dev-master. Thus,
+// ranges covering all numbered versions, e.g.: '<2.0 || >=1.0', are compacted
+// into '>=0-dev' instead of a match all.
 func Compact(o Or) Constrainter { //nolint:cyclop,ireturn
 	if len(o) == 0 {
 		return Or{}
@@ -22,14 +26,18 @@ func Compact(o Or) Constrainter { //nolint:cyclop,ireturn
 		return NewMatchAll()
 	}
 
+	if slices.ContainsFunc(o, branchBounded) {
+		return compactWithBranches(o)
+	}
+
 	o = slices.Clone(o)
 
 	ceiling, ceilingOk := maxFloorlessCeiling(o...)
 	floor, floorOk := minCeilinglessFloor(o...)
 
-	// short circuit if we have a match all
+	// short circuit if we have all numbered versions
 	if ceilingOk && floorOk && disjunctivelyCombineToMatchAll(ceiling, floor) {
-		return NewMatchAll()
+		return numericMatchAll()
 	}
 
 	o = slices.DeleteFunc(o, func(c CeilingFloorConstrainter) bool {
@@ -63,6 +71,51 @@ func Compact(o Or) Constrainter { //nolint:cyclop,ireturn
 	return slices.Clip(r)
 }
 
+// compactWithBranches compacts the [CeilingFloorConstrainter] instances bounded
+// by named dev branches separately, see [set].
+func compactWithBranches(o Or) Constrainter { //nolint:ireturn
+	bs := slices.Clone(o)
+	bs = slices.DeleteFunc(bs, func(c CeilingFloorConstrainter) bool {
+		return !branchBounded(c)
+	})
+
+	slices.SortFunc(bs, compare)
+	bs = slices.CompactFunc(bs, func(a, b CeilingFloorConstrainter) bool {
+		return compare(a, b) == 0
+	})
+
+	ns := slices.Clone(o)
+	ns = slices.DeleteFunc(ns, branchBounded)
+
+	var r Or
+
+	switch c := Compact(ns).(type) {
+	case Or:
+		r = slices.Clone(c)
+	case CeilingFloorConstrainter:
+		if matchAll(c) {
+			return c
+		}
+
+		r = Or{c}
+	}
+
+	r = append(r, bs...)
+
+	if len(r) == 1 {
+		return r[0]
+	}
+
+	return slices.Clip(r)
+}
+
+func branchBounded(c CeilingFloorConstrainter) bool {
+	floor, ceiling := c.floor(), c.ceiling()
+
+	return (!floor.matchAll() && floor.version.isBranch()) ||
+		(!ceiling.matchAll() && ceiling.version.isBranch())
+}
+
 func matchAll(c CeilingFloorConstrainter) bool {
 	return c.floor().matchAll() && c.ceiling().matchAll()
 }
@@ -208,7 +261,7 @@ func compactTwo(a, b CeilingFloorConstrainter) (CeilingFloorConstrainter, bool)
 		return a, true
 	}
 
-	return interval{
+	return Interval{
 		upper: b.ceiling(),
 		lower: a.floor(),
 	}, true
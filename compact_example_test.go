@@ -64,7 +64,7 @@ func ExampleCompact_matchAll() {
 
 	// Output:
 	// Before: <3 || >2
-	// After: *
+	// After: >=0-dev
 }
 
 func ExampleCompact_matchAllTrumps() {
@@ -55,12 +55,12 @@ func TestCompact(t *testing.T) {
 		{
 			name: "single_interval",
 			o: Or{
-				interval{
+				Interval{
 					upper: NewLessThan(MustParse("7")),
 					lower: NewGreaterThan(MustParse("6")),
 				},
 			},
-			want: interval{
+			want: Interval{
 				upper: NewLessThan(MustParse("7")),
 				lower: NewGreaterThan(MustParse("6")),
 			},
@@ -87,7 +87,7 @@ func TestCompact(t *testing.T) {
 				NewGreaterThanOrEqualTo(MustParse("4")),
 				NewGreaterThan(MustParse("4")),
 				NewExactConstraint(MustParse("5")),
-				interval{
+				Interval{
 					upper: NewLessThan(MustParse("7")),
 					lower: NewGreaterThan(MustParse("6")),
 				},
@@ -101,7 +101,7 @@ func TestCompact(t *testing.T) {
 				NewLessThan(MustParse("10")),
 				NewGreaterThan(MustParse("9")),
 			},
-			want: NewMatchAll(),
+			want: numericMatchAll(),
 		},
 		{
 			name: "match_all_same_version_ceiling_inclusive_floor_inclusive",
@@ -109,7 +109,7 @@ func TestCompact(t *testing.T) {
 				NewLessThanOrEqualTo(MustParse("10")),
 				NewGreaterThanOrEqualTo(MustParse("10")),
 			},
-			want: NewMatchAll(),
+			want: numericMatchAll(),
 		},
 		{
 			name: "match_all_same_version_ceiling_non_inclusive_floor_inclusive",
@@ -117,7 +117,7 @@ func TestCompact(t *testing.T) {
 				NewLessThan(MustParse("10")),
 				NewGreaterThanOrEqualTo(MustParse("10")),
 			},
-			want: NewMatchAll(),
+			want: numericMatchAll(),
 		},
 		{
 			name: "match_all_same_version_ceiling_inclusive_floor_non_inclusive",
@@ -125,7 +125,7 @@ func TestCompact(t *testing.T) {
 				NewLessThanOrEqualTo(MustParse("10")),
 				NewGreaterThan(MustParse("10")),
 			},
-			want: NewMatchAll(),
+			want: numericMatchAll(),
 		},
 		{
 			name: "same_version_not_match_all",
@@ -141,29 +141,29 @@ func TestCompact(t *testing.T) {
 		{
 			name: "unrelated_intervals",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("14")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("17")),
 					upper: NewLessThan(MustParse("20")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("23")),
 					upper: NewLessThan(MustParse("26")),
 				},
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("14")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("17")),
 					upper: NewLessThan(MustParse("20")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("23")),
 					upper: NewLessThan(MustParse("26")),
 				},
@@ -172,25 +172,25 @@ func TestCompact(t *testing.T) {
 		{
 			name: "overlapping_intervals",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("18")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("17")),
 					upper: NewLessThan(MustParse("20")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("23")),
 					upper: NewLessThan(MustParse("26")),
 				},
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("20")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("23")),
 					upper: NewLessThan(MustParse("26")),
 				},
@@ -199,25 +199,25 @@ func TestCompact(t *testing.T) {
 		{
 			name: "overlapping_intervals",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("14")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("13")),
 					upper: NewLessThan(MustParse("20")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("23")),
 					upper: NewLessThan(MustParse("26")),
 				},
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("20")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("23")),
 					upper: NewLessThan(MustParse("26")),
 				},
@@ -226,25 +226,25 @@ func TestCompact(t *testing.T) {
 		{
 			name: "overlapping_intervals",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("14")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("17")),
 					upper: NewLessThan(MustParse("24")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("23")),
 					upper: NewLessThan(MustParse("26")),
 				},
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("14")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("17")),
 					upper: NewLessThan(MustParse("26")),
 				},
@@ -253,25 +253,25 @@ func TestCompact(t *testing.T) {
 		{
 			name: "overlapping_intervals",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("14")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("17")),
 					upper: NewLessThan(MustParse("20")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("19")),
 					upper: NewLessThan(MustParse("26")),
 				},
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("14")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("17")),
 					upper: NewLessThan(MustParse("26")),
 				},
@@ -280,20 +280,20 @@ func TestCompact(t *testing.T) {
 		{
 			name: "overlapping_intervals",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("25")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("17")),
 					upper: NewLessThan(MustParse("20")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("19")),
 					upper: NewLessThan(MustParse("26")),
 				},
 			},
-			want: interval{
+			want: Interval{
 				lower: NewGreaterThan(MustParse("11")),
 				upper: NewLessThan(MustParse("26")),
 			},
@@ -301,20 +301,20 @@ func TestCompact(t *testing.T) {
 		{
 			name: "overlapping_intervals",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("14")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("17")),
 					upper: NewLessThan(MustParse("20")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("12")),
 					upper: NewLessThan(MustParse("26")),
 				},
 			},
-			want: interval{
+			want: Interval{
 				lower: NewGreaterThan(MustParse("11")),
 				upper: NewLessThan(MustParse("26")),
 			},
@@ -322,20 +322,20 @@ func TestCompact(t *testing.T) {
 		{
 			name: "overlapping_intervals",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("20")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("17")),
 					upper: NewLessThan(MustParse("20")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("19")),
 					upper: NewLessThan(MustParse("26")),
 				},
 			},
-			want: interval{
+			want: Interval{
 				lower: NewGreaterThan(MustParse("11")),
 				upper: NewLessThan(MustParse("26")),
 			},
@@ -343,25 +343,25 @@ func TestCompact(t *testing.T) {
 		{
 			name: "continuous_intervals",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThanOrEqualTo(MustParse("17")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("17")),
 					upper: NewLessThan(MustParse("20")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("23")),
 					upper: NewLessThan(MustParse("26")),
 				},
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("20")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("23")),
 					upper: NewLessThan(MustParse("26")),
 				},
@@ -370,25 +370,25 @@ func TestCompact(t *testing.T) {
 		{
 			name: "continuous_intervals",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("17")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThanOrEqualTo(MustParse("17")),
 					upper: NewLessThan(MustParse("20")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("23")),
 					upper: NewLessThan(MustParse("26")),
 				},
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("20")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("23")),
 					upper: NewLessThan(MustParse("26")),
 				},
@@ -397,25 +397,25 @@ func TestCompact(t *testing.T) {
 		{
 			name: "continuous_intervals",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("14")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("17")),
 					upper: NewLessThanOrEqualTo(MustParse("23")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("23")),
 					upper: NewLessThan(MustParse("26")),
 				},
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("14")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("17")),
 					upper: NewLessThan(MustParse("26")),
 				},
@@ -424,25 +424,25 @@ func TestCompact(t *testing.T) {
 		{
 			name: "continuous_intervals",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("14")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("17")),
 					upper: NewLessThanOrEqualTo(MustParse("23")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("23")),
 					upper: NewLessThan(MustParse("26")),
 				},
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("14")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("17")),
 					upper: NewLessThan(MustParse("26")),
 				},
@@ -451,20 +451,20 @@ func TestCompact(t *testing.T) {
 		{
 			name: "continuous_intervals",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("14")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThanOrEqualTo(MustParse("14")),
 					upper: NewLessThanOrEqualTo(MustParse("23")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("23")),
 					upper: NewLessThan(MustParse("26")),
 				},
 			},
-			want: interval{
+			want: Interval{
 				lower: NewGreaterThan(MustParse("11")),
 				upper: NewLessThan(MustParse("26")),
 			},
@@ -472,22 +472,22 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
 				NewGreaterThanOrEqualTo(MustParse("18")),
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -497,18 +497,18 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
 				NewGreaterThanOrEqualTo(MustParse("17")),
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
@@ -518,18 +518,18 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThanOrEqualTo(MustParse("17")),
 				},
 				NewGreaterThanOrEqualTo(MustParse("17")),
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
@@ -539,18 +539,18 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
 				NewGreaterThanOrEqualTo(MustParse("16")),
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
@@ -560,18 +560,18 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
 				NewGreaterThanOrEqualTo(MustParse("15")),
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
@@ -581,18 +581,18 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThanOrEqualTo(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
 				NewGreaterThanOrEqualTo(MustParse("15")),
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
@@ -602,18 +602,18 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
 				NewGreaterThanOrEqualTo(MustParse("14")),
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
@@ -623,11 +623,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -638,11 +638,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThanOrEqualTo(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -653,11 +653,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -668,11 +668,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -683,11 +683,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThanOrEqualTo(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -698,11 +698,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -714,22 +714,22 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
 				NewGreaterThan(MustParse("18")),
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -739,22 +739,22 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
 				NewGreaterThan(MustParse("17")),
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -764,18 +764,18 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThanOrEqualTo(MustParse("17")),
 				},
 				NewGreaterThan(MustParse("17")),
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
@@ -785,18 +785,18 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
 				NewGreaterThan(MustParse("16")),
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
@@ -806,18 +806,18 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
 				NewGreaterThan(MustParse("15")),
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
@@ -827,18 +827,18 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThanOrEqualTo(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
 				NewGreaterThan(MustParse("15")),
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
@@ -848,18 +848,18 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
 				NewGreaterThan(MustParse("14")),
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
@@ -869,18 +869,18 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
 				NewGreaterThan(MustParse("13")),
 			},
 			want: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
@@ -890,11 +890,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThanOrEqualTo(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -905,11 +905,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -920,11 +920,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -935,11 +935,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThanOrEqualTo(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -950,11 +950,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "tail_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -965,11 +965,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -980,11 +980,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -995,11 +995,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThanOrEqualTo(MustParse("17")),
 				},
@@ -1010,11 +1010,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1025,11 +1025,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1037,7 +1037,7 @@ func TestCompact(t *testing.T) {
 			},
 			want: Or{
 				NewLessThan(MustParse("15")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1046,11 +1046,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThanOrEqualTo(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1061,11 +1061,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1073,7 +1073,7 @@ func TestCompact(t *testing.T) {
 			},
 			want: Or{
 				NewLessThan(MustParse("14")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1082,11 +1082,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1094,7 +1094,7 @@ func TestCompact(t *testing.T) {
 			},
 			want: Or{
 				NewLessThan(MustParse("13")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1103,11 +1103,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThanOrEqualTo(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1115,7 +1115,7 @@ func TestCompact(t *testing.T) {
 			},
 			want: Or{
 				NewLessThanOrEqualTo(MustParse("13")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1124,11 +1124,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1136,7 +1136,7 @@ func TestCompact(t *testing.T) {
 			},
 			want: Or{
 				NewLessThan(MustParse("13")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1145,11 +1145,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1157,11 +1157,11 @@ func TestCompact(t *testing.T) {
 			},
 			want: Or{
 				NewLessThan(MustParse("11")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1170,11 +1170,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThanOrEqualTo(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1182,7 +1182,7 @@ func TestCompact(t *testing.T) {
 			},
 			want: Or{
 				NewLessThan(MustParse("13")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1191,11 +1191,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_non_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1203,11 +1203,11 @@ func TestCompact(t *testing.T) {
 			},
 			want: Or{
 				NewLessThan(MustParse("10")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1216,11 +1216,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1231,11 +1231,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1246,11 +1246,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThanOrEqualTo(MustParse("17")),
 				},
@@ -1261,11 +1261,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1276,11 +1276,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1291,11 +1291,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThanOrEqualTo(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1306,11 +1306,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1318,7 +1318,7 @@ func TestCompact(t *testing.T) {
 			},
 			want: Or{
 				NewLessThanOrEqualTo(MustParse("14")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1327,11 +1327,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1339,7 +1339,7 @@ func TestCompact(t *testing.T) {
 			},
 			want: Or{
 				NewLessThanOrEqualTo(MustParse("13")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1348,11 +1348,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThanOrEqualTo(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1360,7 +1360,7 @@ func TestCompact(t *testing.T) {
 			},
 			want: Or{
 				NewLessThanOrEqualTo(MustParse("13")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1369,11 +1369,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1381,7 +1381,7 @@ func TestCompact(t *testing.T) {
 			},
 			want: Or{
 				NewLessThan(MustParse("13")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1390,11 +1390,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1402,7 +1402,7 @@ func TestCompact(t *testing.T) {
 			},
 			want: Or{
 				NewLessThan(MustParse("13")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1411,11 +1411,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThanOrEqualTo(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1423,7 +1423,7 @@ func TestCompact(t *testing.T) {
 			},
 			want: Or{
 				NewLessThan(MustParse("13")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1432,11 +1432,11 @@ func TestCompact(t *testing.T) {
 		{
 			name: "head_inclusive",
 			o: Or{
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1444,11 +1444,11 @@ func TestCompact(t *testing.T) {
 			},
 			want: Or{
 				NewLessThanOrEqualTo(MustParse("10")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("11")),
 					upper: NewLessThan(MustParse("13")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("15")),
 					upper: NewLessThan(MustParse("17")),
 				},
@@ -1458,41 +1458,87 @@ func TestCompact(t *testing.T) {
 			name: "match_all_with_interval",
 			o: Or{
 				NewGreaterThan(MustParse("1")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("2")),
 					upper: NewLessThan(MustParse("3")),
 				},
 				NewLessThan(MustParse("4")),
 			},
-			want: NewMatchAll(),
+			want: numericMatchAll(),
 		},
 		{
 			name: "match_all_within_interval",
 			o: Or{
 				NewGreaterThan(MustParse("2")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("1")),
 					upper: NewLessThan(MustParse("4")),
 				},
 				NewLessThan(MustParse("3")),
 			},
-			want: NewMatchAll(),
+			want: numericMatchAll(),
+		},
+		{
+			name: "branches",
+			o: Or{
+				NewExactConstraint(MustParse("dev-master")),
+				NewGreaterThanOrEqualTo(MustParse("1")),
+				NewExactConstraint(MustParse("dev-foo")),
+				NewExactConstraint(MustParse("dev-master")),
+			},
+			want: Or{
+				NewGreaterThanOrEqualTo(MustParse("1")),
+				NewExactConstraint(MustParse("dev-foo")),
+				NewExactConstraint(MustParse("dev-master")),
+			},
+		},
+		{
+			name: "branches_compacted",
+			o: Or{
+				NewExactConstraint(MustParse("dev-master")),
+				NewGreaterThan(MustParse("2")),
+				NewGreaterThanOrEqualTo(MustParse("1")),
+			},
+			want: Or{
+				NewGreaterThanOrEqualTo(MustParse("1")),
+				NewExactConstraint(MustParse("dev-master")),
+			},
+		},
+		{
+			name: "branches_only",
+			o: Or{
+				NewExactConstraint(MustParse("dev-master")),
+				NewExactConstraint(MustParse("dev-master")),
+			},
+			want: NewExactConstraint(MustParse("dev-master")),
+		},
+		{
+			name: "branches_match_all",
+			o: Or{
+				NewExactConstraint(MustParse("dev-master")),
+				NewLessThan(MustParse("2")),
+				NewGreaterThan(MustParse("1")),
+			},
+			want: Or{
+				numericMatchAll(),
+				NewExactConstraint(MustParse("dev-master")),
+			},
 		},
 		{
 			name: "match_all_within_intervals",
 			o: Or{
 				NewLessThan(MustParse("3")),
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("2")),
 					upper: NewLessThan(MustParse("6")),
 				},
-				interval{
+				Interval{
 					lower: NewGreaterThan(MustParse("5")),
 					upper: NewLessThan(MustParse("8")),
 				},
 				NewGreaterThan(MustParse("7")),
 			},
-			want: NewMatchAll(),
+			want: numericMatchAll(),
 		},
 	}
 	for _, tt := range tests {
@@ -1559,7 +1605,7 @@ func Test_matchAll(t *testing.T) {
 		},
 		{
 			name: "interval",
-			c: interval{
+			c: Interval{
 				upper: NewLessThan(MustParse("7")),
 				lower: NewGreaterThan(MustParse("6")),
 			},
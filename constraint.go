@@ -4,9 +4,16 @@ type Constrainter interface {
 	// Check reports whether a [Version] satisfies the constraint.
 	Check(v Version) bool
 	String() string
+	// Accept calls the [Visitor] method matching the type of the constraint.
+	Accept(v Visitor)
 }
 
 type CeilingFloorConstrainter interface {
+	// Lower returns the lower bound of the constraint.
+	Lower() Bound
+	// Upper returns the upper bound of the constraint.
+	Upper() Bound
+
 	ceiling() Endless
 	floor() Endless
 
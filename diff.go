@@ -0,0 +1,80 @@
+package comver
+
+// Change represents the kind of change from one [Version] to another, see
+// [Diff]. The zero value for Change is [ChangeNone].
+type Change int8
+
+const (
+	// ChangeNone means both versions are equal, e.g.: '1.0' to '1.0.0.0'.
+	ChangeNone Change = iota
+	// ChangeMajor means the major is upgraded, e.g.: '1.2.3' to '2.0.0'.
+	ChangeMajor
+	// ChangeMinor means the minor is upgraded, e.g.: '1.2.3' to '1.3.0'.
+	ChangeMinor
+	// ChangePatch means the patch is upgraded, e.g.: '1.2.3' to '1.2.4'.
+	ChangePatch
+	// ChangeTweak means the tweak is upgraded, e.g.: '1.2.3.4' to '1.2.3.5'.
+	ChangeTweak
+	// ChangePreRelease means only the pre-release is upgraded, e.g.:
+	// '1.2.3-beta2' to '1.2.3-RC1', or '1.2.3-RC1' to '1.2.3'.
+	ChangePreRelease
+	// ChangeBranch means either version is a named dev branch, e.g.:
+	// '1.2.3' to 'dev-master'.
+	ChangeBranch
+	// ChangeDowngrade means the version is downgraded, e.g.: '1.2.3' to
+	// '1.2.2'.
+	ChangeDowngrade
+)
+
+// Diff classifies the change from a to b by the most significant component
+// that is upgraded, using the same component ordering as [Version.Compare].
+// CalVer (as major) are compared as majors, e.g.: '20240102' to '20240103'
+// is a [ChangeMajor].
+//
+// Named dev branches have no components, thus any change from or to a named
+// dev branch is a [ChangeBranch].
+func Diff(a, b Version) Change {
+	cmp := a.Compare(b)
+
+	switch {
+	case cmp == 0:
+		return ChangeNone
+	case a.isBranch() || b.isBranch():
+		return ChangeBranch
+	case cmp > 0:
+		return ChangeDowngrade
+	case a.major != b.major:
+		return ChangeMajor
+	case a.minor != b.minor:
+		return ChangeMinor
+	case a.patch != b.patch:
+		return ChangePatch
+	case a.tweak != b.tweak:
+		return ChangeTweak
+	default:
+		return ChangePreRelease
+	}
+}
+
+func (c Change) String() string {
+	switch c {
+	case ChangeNone:
+		return "none"
+	case ChangeMajor:
+		return "major"
+	case ChangeMinor:
+		return "minor"
+	case ChangePatch:
+		return "patch"
+	case ChangeTweak:
+		return "tweak"
+	case ChangePreRelease:
+		return "pre-release"
+	case ChangeBranch:
+		return "branch"
+	case ChangeDowngrade:
+		return "downgrade"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,22 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleDiff() {
+	a := comver.MustParse("1.2.3")
+
+	fmt.Println(comver.Diff(a, comver.MustParse("2.0.0")))
+	fmt.Println(comver.Diff(a, comver.MustParse("1.2.4")))
+	fmt.Println(comver.Diff(comver.MustParse("1.2.3-RC1"), a))
+	fmt.Println(comver.Diff(a, comver.MustParse("1.2.2")))
+
+	// Output:
+	// major
+	// patch
+	// pre-release
+	// downgrade
+}
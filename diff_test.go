@@ -0,0 +1,85 @@
+package comver
+
+import "testing"
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a    string
+		b    string
+		want Change
+	}{
+		{"1.2.3", "1.2.3", ChangeNone},
+		{"1.0", "1.0.0.0", ChangeNone},
+		{"v1.0.0", "1.0.0+foo", ChangeNone},
+		{"1.2.3", "2.0.0", ChangeMajor},
+		{"1.2.3", "2.5.6", ChangeMajor},
+		{"1.2.3-beta", "2.0.0-alpha", ChangeMajor},
+		{"1.2.3", "1.3.0", ChangeMinor},
+		{"1.2.3", "1.3.0-RC1", ChangeMinor},
+		{"1.2.3", "1.2.4", ChangePatch},
+		{"1.2.3-RC1", "1.2.4", ChangePatch},
+		{"1.2.3.4", "1.2.3.5", ChangeTweak},
+		{"1.2.3", "1.2.3.1", ChangeTweak},
+		{"1.2.3-beta2", "1.2.3-beta3", ChangePreRelease},
+		{"1.2.3-beta2", "1.2.3-RC1", ChangePreRelease},
+		{"1.2.3-RC1", "1.2.3", ChangePreRelease},
+		{"1.2.3-RC1-dev", "1.2.3-RC1", ChangePreRelease},
+		{"1.2.3-dev", "1.2.3-alpha", ChangePreRelease},
+		{"1.2.3", "1.2.3-patch1", ChangePreRelease},
+		{"1.2.3", "1.2.2", ChangeDowngrade},
+		{"2.0.0", "1.9.9", ChangeDowngrade},
+		{"1.2.3", "1.2.3-RC1", ChangeDowngrade},
+		{"1.2.3-patch1", "1.2.3", ChangeDowngrade},
+		{"20240102", "20240103", ChangeMajor},
+		{"202401021530", "202401021531", ChangeMajor},
+		{"20240102", "20240102.1", ChangeMinor},
+		{"1.2.3", "20240102", ChangeMajor},
+		{"20240102", "1.2.3", ChangeDowngrade},
+		{"1.x-dev", "1.2.3", ChangeDowngrade},
+		{"1.2.3", "1.x-dev", ChangeMinor},
+		{"dev-master", "dev-master", ChangeNone},
+		{"1.2.3", "dev-master", ChangeBranch},
+		{"dev-master", "1.2.3", ChangeBranch},
+		{"dev-main", "dev-master", ChangeBranch},
+		{"dev-master", "dev-main", ChangeBranch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" to "+tt.b, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Diff(MustParse(tt.a), MustParse(tt.b)); got != tt.want {
+				t.Errorf("Diff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChange_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    Change
+		want string
+	}{
+		{ChangeNone, "none"},
+		{ChangeMajor, "major"},
+		{ChangeMinor, "minor"},
+		{ChangePatch, "patch"},
+		{ChangeTweak, "tweak"},
+		{ChangePreRelease, "pre-release"},
+		{ChangeBranch, "branch"},
+		{ChangeDowngrade, "downgrade"},
+		{Change(-1), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.c.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package comver
+
+// Difference returns a [Constrainter] instance representing the versions
+// satisfying a but not b, i.e.: a AND NOT b. For example, '^1.0' minus
+// '>=1.5' is '>=1-dev <1.5-dev'.
+//
+// The result is [Compact]-ed, usually an [Or] of the leftover intervals.
+// When every version satisfying a also satisfies b, [MatchNone] is returned,
+// see [Implies].
+//
+// Same as [Not], when the leftover could not be expressed by composer, the
+// named dev branches are dropped, e.g.: '*' minus '^1.0' is
+// '<1-dev || >=2-dev'. Otherwise, they are kept, e.g.: 'dev-master || ^1.0'
+// minus '^1.0' is 'dev-master'.
+//
+// When a is a [FlaggedConstraint], the result is a [FlaggedConstraint] with the
+// same flag. The flag of b is ignored.
+func Difference(a, b Constrainter) Constrainter { //nolint:ireturn
+	return flagged(setOf(a).intersect(setOf(b).complement()).constraint(), a)
+}
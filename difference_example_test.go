@@ -0,0 +1,19 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleDifference() {
+	before := comver.MustParseConstraint("^1.0")
+	after := comver.MustParseConstraint("^1.0 || ^2.0")
+
+	fmt.Println(comver.Difference(after, before))
+	fmt.Printf("%q\n", comver.Difference(before, after))
+
+	// Output:
+	// >=2-dev <3-dev
+	// "[]"
+}
@@ -0,0 +1,163 @@
+package comver
+
+import "testing"
+
+func TestDifference(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		a    Constrainter
+		b    Constrainter
+		want string
+	}{
+		{
+			name: "upper",
+			a:    MustParseConstraint("^1.0"),
+			b:    MustParseConstraint(">=1.5"),
+			want: ">=1-dev <1.5-dev",
+		},
+		{
+			name: "lower",
+			a:    MustParseConstraint("^1.0"),
+			b:    MustParseConstraint("<1.5"),
+			want: ">=1.5-dev <2-dev",
+		},
+		{
+			name: "middle",
+			a:    MustParseConstraint("^1.0"),
+			b:    MustParseConstraint(">=1.2 <1.5"),
+			want: ">=1-dev <1.2-dev || >=1.5-dev <2-dev",
+		},
+		{
+			name: "exact",
+			a:    MustParseConstraint("^1.0"),
+			b:    MustParseConstraint("1.5"),
+			want: ">=1-dev <1.5 || >1.5 <2-dev",
+		},
+		{
+			name: "not_equal",
+			a:    MustParseConstraint("^1.0"),
+			b:    NewNotEqual(MustParse("1.5")),
+			want: "1.5",
+		},
+		{
+			name: "upgrade",
+			a:    MustParseConstraint("^1.0 || ^2.0"),
+			b:    MustParseConstraint("^1.0"),
+			want: ">=2-dev <3-dev",
+		},
+		{
+			name: "or_minus_or",
+			a:    MustParseConstraint(">=1.0 <5.0"),
+			b:    MustParseConstraint("^1.0 || ^3.0"),
+			want: ">=2-dev <3-dev || >=4-dev <5-dev",
+		},
+		{
+			name: "disjoint",
+			a:    MustParseConstraint("^1.0"),
+			b:    MustParseConstraint("^2.0"),
+			want: ">=1-dev <2-dev",
+		},
+		{
+			name: "subset",
+			a:    MustParseConstraint("~1.2.3"),
+			b:    MustParseConstraint("^1.0"),
+			want: "[]",
+		},
+		{
+			name: "same",
+			a:    MustParseConstraint("^1.0"),
+			b:    MustParseConstraint("^1.0"),
+			want: "[]",
+		},
+		{
+			name: "inclusive",
+			a:    NewLessThanOrEqualTo(MustParse("2")),
+			b:    NewLessThan(MustParse("2")),
+			want: "2",
+		},
+		{
+			name: "match_all",
+			a:    NewMatchAll(),
+			b:    MustParseConstraint("^1.0"),
+			want: "<1-dev || >=2-dev",
+		},
+		{
+			name: "minus_match_all",
+			a:    MustParseConstraint("^1.0"),
+			b:    NewMatchAll(),
+			want: "[]",
+		},
+		{
+			name: "minus_match_none",
+			a:    MustParseConstraint("^1.0"),
+			b:    Or{},
+			want: ">=1-dev <2-dev",
+		},
+		{
+			name: "match_none",
+			a:    Or{},
+			b:    MustParseConstraint("^1.0"),
+			want: "[]",
+		},
+		{
+			name: "branch",
+			a:    MustParseConstraint("dev-master || ^1.0"),
+			b:    MustParseConstraint("^1.0"),
+			want: "dev-master",
+		},
+		{
+			name: "branch_minus_branch",
+			a:    MustParseConstraint("dev-master || dev-foo || ^1.0"),
+			b:    MustParseConstraint("dev-foo || >=1.5"),
+			want: ">=1-dev <1.5-dev || dev-master",
+		},
+		{
+			name: "flagged",
+			a:    MustParseConstraint("^1.0@beta"),
+			b:    MustParseConstraint(">=1.5@dev"),
+			want: ">=1-dev <1.5-dev@beta",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Difference(tt.a, tt.b).String(); got != tt.want {
+				t.Errorf("Difference(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDifference_Check(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a string
+		b string
+	}{
+		{"^1.0", ">=1.5"},
+		{"^1.0 || ^2.0", "^1.0"},
+		{">=1.0 <5.0", "^1.0 || ^3.0"},
+		{"1.0 - 2.0", "~1.2"},
+		{"^1.0", "!=1.5"},
+	}
+	vs := []string{"0.9", "1.0.0-dev", "1.0", "1.2", "1.4.9", "1.5.0-dev", "1.5", "2.0", "2.1", "3.5", "4.0", "5.0"}
+
+	for _, tt := range tests {
+		for _, v := range vs {
+			t.Run(tt.a+"/"+tt.b+"/"+v, func(t *testing.T) {
+				t.Parallel()
+
+				a, b := MustParseConstraint(tt.a), MustParseConstraint(tt.b)
+				pv := MustParse(v)
+
+				if got, want := Difference(a, b).Check(pv), a.Check(pv) && !b.Check(pv); got != want {
+					t.Errorf("Difference(%q, %q).Check(%q) = %v, want %v", tt.a, tt.b, v, got, want)
+				}
+			})
+		}
+	}
+}
@@ -55,6 +55,11 @@ func (b Endless) Check(v Version) bool {
 		return true
 	}
 
+	if b.version.isBranch() || v.isBranch() {
+		// named dev branches are not comparable with ranges
+		return false
+	}
+
 	cmp := b.version.Compare(v)
 
 	switch b.op {
@@ -80,6 +85,18 @@ func (b Endless) String() string {
 	return b.op.String() + b.version.Short()
 }
 
+// Lower returns the lower bound of the constraint, e.g.: inclusive 1.0 for
+// '>=1.0'. Ceiling bounded constraints and match all are unbounded.
+func (b Endless) Lower() Bound {
+	return newBound(b.floor())
+}
+
+// Upper returns the upper bound of the constraint, e.g.: exclusive 2.0 for
+// '<2.0'. Floor bounded constraints and match all are unbounded.
+func (b Endless) Upper() Bound {
+	return newBound(b.ceiling())
+}
+
 func (b Endless) ceiling() Endless {
 	if !b.ceilingBounded() {
 		return NewMatchAll()
@@ -96,6 +113,15 @@ func (b Endless) floor() Endless {
 	return b
 }
 
+// negate returns the [Endless] satisfied by exactly the versions not
+// satisfying b, e.g.: '<1.0' for '>=1.0'. It must not be called on a match all.
+func (b Endless) negate() Endless {
+	return Endless{
+		version: b.version,
+		op:      b.op.negate(),
+	}
+}
+
 func (b Endless) matchAll() bool {
 	return b.version == nil
 }
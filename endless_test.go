@@ -119,6 +119,55 @@ func TestEndless_Check(t *testing.T) {
 			version: MustParse("1"),
 			want:    true,
 		},
+
+		{
+			name:    "dev_satisfied",
+			endless: NewGreaterThanOrEqualTo(MustParse("2-dev")),
+			version: MustParse("2-alpha"),
+			want:    true,
+		},
+		{
+			name:    "dev_not_satisfied",
+			endless: NewLessThan(MustParse("2-dev")),
+			version: MustParse("2-alpha-dev"),
+			want:    false,
+		},
+		{
+			name:    "numeric_branch_satisfied",
+			endless: NewLessThan(MustParse("2-dev")),
+			version: MustParse("1.x-dev"),
+			want:    true,
+		},
+		{
+			name:    "branch_lessThan",
+			endless: NewLessThan(MustParse("2")),
+			version: MustParse("dev-master"),
+			want:    false,
+		},
+		{
+			name:    "branch_greaterThan",
+			endless: NewGreaterThan(MustParse("2")),
+			version: MustParse("dev-master"),
+			want:    false,
+		},
+		{
+			name:    "branch_bounded",
+			endless: NewGreaterThanOrEqualTo(MustParse("dev-master")),
+			version: MustParse("dev-master"),
+			want:    false,
+		},
+		{
+			name:    "branch_bounded_numbered",
+			endless: NewLessThanOrEqualTo(MustParse("dev-master")),
+			version: MustParse("1"),
+			want:    false,
+		},
+		{
+			name:    "branch_matchAll",
+			endless: NewMatchAll(),
+			version: MustParse("dev-master"),
+			want:    true,
+		},
 	}
 
 	for _, tt := range tests {
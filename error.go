@@ -24,3 +24,42 @@ func (e ParseError) Unwrap() error {
 func (e ParseError) Original() string {
 	return e.original
 }
+
+type ConstraintParseError struct {
+	original string
+	wrapped  error
+}
+
+func (e ConstraintParseError) Error() string {
+	return fmt.Sprintf("error parsing constraint string %q", e.original)
+}
+
+func (e ConstraintParseError) Unwrap() error {
+	return e.wrapped
+}
+
+func (e ConstraintParseError) Original() string {
+	return e.original
+}
+
+type IntervalError struct {
+	lower   Version
+	upper   Version
+	wrapped error
+}
+
+func (e IntervalError) Error() string {
+	return fmt.Sprintf("error creating interval from %q to %q", e.lower.Short(), e.upper.Short())
+}
+
+func (e IntervalError) Unwrap() error {
+	return e.wrapped
+}
+
+func (e IntervalError) Lower() Version {
+	return e.lower
+}
+
+func (e IntervalError) Upper() Version {
+	return e.upper
+}
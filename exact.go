@@ -19,6 +19,18 @@ func (e ExactConstraint) String() string {
 	return e.version.Short()
 }
 
+// Lower returns the lower bound of the constraint, which is the inclusive
+// version of the constraint.
+func (e ExactConstraint) Lower() Bound {
+	return newBound(e.floor())
+}
+
+// Upper returns the upper bound of the constraint, which is the inclusive
+// version of the constraint.
+func (e ExactConstraint) Upper() Bound {
+	return newBound(e.ceiling())
+}
+
 func (e ExactConstraint) ceiling() Endless {
 	return NewLessThanOrEqualTo(e.version)
 }
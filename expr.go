@@ -0,0 +1,104 @@
+package comver
+
+import "strings"
+
+// AllOf represents a logical AND operation between multiple [Constrainter]
+// instances, which could be nested, e.g.: '(^1.0 || ^2.0) AND >=1.5'.
+// The zero value for AllOf is a match all constraint which is always satisfied.
+//
+// Use [Normalize] to convert it into a [Compact]-ed [Constrainter].
+type AllOf []Constrainter
+
+// Check reports whether a [Version] satisfies the constraint.
+func (a AllOf) Check(v Version) bool {
+	for i := range a {
+		if !a[i].Check(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns the string representation of the constraint, with nested
+// disjunctions wrapped in parentheses, e.g.: '(>=1 <2 || >=3) !=1.5'.
+// Note that [ParseConstraint] does not support parentheses.
+func (a AllOf) String() string {
+	if len(a) == 0 {
+		return "*"
+	}
+
+	ss := make([]string, len(a))
+	for i := range a {
+		ss[i] = a[i].String()
+
+		if disjunctive(a[i]) {
+			ss[i] = "(" + ss[i] + ")"
+		}
+	}
+
+	return strings.Join(ss, " ")
+}
+
+// AnyOf represents a logical OR operation between multiple [Constrainter]
+// instances, which could be nested, e.g.: '^1.0 || (>=2.0 AND !=2.1)'.
+// The zero value for AnyOf is a match none constraint which could never be
+// satisfied.
+//
+// Use [Normalize] to convert it into a [Compact]-ed [Constrainter].
+type AnyOf []Constrainter
+
+// Check reports whether a [Version] satisfies the constraint.
+func (a AnyOf) Check(v Version) bool {
+	for i := range a {
+		if a[i].Check(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a AnyOf) String() string {
+	ss := make([]string, len(a))
+	for i := range a {
+		ss[i] = a[i].String()
+	}
+
+	return strings.Join(ss, " || ")
+}
+
+// Normalize returns a [Compact]-ed [Constrainter] that is logically equivalent
+// to the given nested [AllOf] or [AnyOf], in the same forms as
+// [ParseConstraint] results, e.g.: an [Or] of [CeilingFloorConstrainter].
+//
+// Conjunctions are distributed over disjunctions via [Intersect], e.g.:
+// '(^1.0 || ^2.0) AND >=1.5' becomes '>=1.5-dev <3-dev'. Nested constraints
+// which could never be satisfied become [MatchNone]. Other [Constrainter]
+// instances are returned as is.
+//
+// When any [FlaggedConstraint] is nested, the result is a [FlaggedConstraint]
+// with the least stable flag.
+func Normalize(c Constrainter) Constrainter { //nolint:ireturn
+	switch c.(type) {
+	case AllOf, AnyOf:
+		return flagged(setOf(c).constraint(), c)
+	default:
+		return c
+	}
+}
+
+// disjunctive reports whether the string representation of the [Constrainter]
+// contains top level '||'.
+func disjunctive(c Constrainter) bool {
+	switch c := c.(type) {
+	case Or:
+		return len(c) > 1
+	case AnyOf:
+		return len(c) > 1
+	case FlaggedConstraint:
+		return disjunctive(c.constraint)
+	default:
+		return false
+	}
+}
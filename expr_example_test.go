@@ -0,0 +1,25 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleNormalize() {
+	c := comver.AllOf{
+		comver.AnyOf{
+			comver.MustParseConstraint("^1.0"),
+			comver.MustParseConstraint("^2.0"),
+		},
+		comver.MustParseConstraint(">=1.5"),
+		comver.NewNotEqual(comver.MustParse("2.1")),
+	}
+
+	fmt.Println(c)
+	fmt.Println(comver.Normalize(c))
+
+	// Output:
+	// (>=1-dev <2-dev || >=2-dev <3-dev) >=1.5-dev !=2.1
+	// >=1.5-dev <2.1 || >2.1 <3-dev
+}
@@ -0,0 +1,261 @@
+package comver
+
+import "testing"
+
+func TestAllOf_Check(t *testing.T) {
+	t.Parallel()
+
+	a := AllOf{
+		AnyOf{MustParseConstraint("^1.0"), MustParseConstraint("^2.0")},
+		MustParseConstraint(">=1.5"),
+		NewNotEqual(MustParse("2.1")),
+	}
+
+	tests := []struct {
+		v    string
+		want bool
+	}{
+		{"1.4", false},
+		{"1.5", true},
+		{"2.0", true},
+		{"2.1", false},
+		{"2.9", true},
+		{"3.0", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			if got := a.Check(MustParse(tt.v)); got != tt.want {
+				t.Errorf("%q.Check(%q) = %v, want %v", a, tt.v, got, tt.want)
+			}
+
+			if got := Normalize(a).Check(MustParse(tt.v)); got != tt.want {
+				t.Errorf("Normalize(%q).Check(%q) = %v, want %v", a, tt.v, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnyOf_Check(t *testing.T) {
+	t.Parallel()
+
+	a := AnyOf{
+		AllOf{MustParseConstraint("^1.0"), NewNotEqual(MustParse("1.5"))},
+		MustParseConstraint("^3.0"),
+	}
+
+	tests := []struct {
+		v    string
+		want bool
+	}{
+		{"0.9", false},
+		{"1.4", true},
+		{"1.5", false},
+		{"2.0", false},
+		{"3.5", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			if got := a.Check(MustParse(tt.v)); got != tt.want {
+				t.Errorf("%q.Check(%q) = %v, want %v", a, tt.v, got, tt.want)
+			}
+
+			if got := Normalize(a).Check(MustParse(tt.v)); got != tt.want {
+				t.Errorf("Normalize(%q).Check(%q) = %v, want %v", a, tt.v, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllOf_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		a    AllOf
+		want string
+	}{
+		{
+			name: "zero",
+			a:    AllOf{},
+			want: "*",
+		},
+		{
+			name: "single",
+			a:    AllOf{MustParseConstraint("^1.0")},
+			want: ">=1-dev <2-dev",
+		},
+		{
+			name: "nested_or",
+			a:    AllOf{MustParseConstraint("^1.0 || ^2.0"), NewNotEqual(MustParse("1.5"))},
+			want: ">=1-dev <3-dev !=1.5",
+		},
+		{
+			name: "nested_or_2",
+			a:    AllOf{MustParseConstraint("^1.0 || ^3.0"), NewNotEqual(MustParse("1.5"))},
+			want: "(>=1-dev <2-dev || >=3-dev <4-dev) !=1.5",
+		},
+		{
+			name: "nested_any_of",
+			a:    AllOf{AnyOf{MustParseConstraint("1.0"), MustParseConstraint("2.0")}, MustParseConstraint(">=1.5")},
+			want: "(1 || 2) >=1.5-dev",
+		},
+		{
+			name: "nested_all_of",
+			a:    AllOf{AllOf{MustParseConstraint(">=1.0"), MustParseConstraint("<2.0")}, MustParseConstraint(">=1.5")},
+			want: ">=1-dev <2-dev >=1.5-dev",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.a.String(); got != tt.want {
+				t.Errorf("AllOf.String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnyOf_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		a    AnyOf
+		want string
+	}{
+		{
+			name: "zero",
+			a:    AnyOf{},
+			want: "",
+		},
+		{
+			name: "nested_all_of",
+			a: AnyOf{
+				AllOf{MustParseConstraint("^1.0"), NewNotEqual(MustParse("1.5"))},
+				MustParseConstraint("^3.0"),
+			},
+			want: ">=1-dev <2-dev !=1.5 || >=3-dev <4-dev",
+		},
+		{
+			name: "nested_any_of",
+			a:    AnyOf{AnyOf{MustParseConstraint("1.0"), MustParseConstraint("2.0")}, MustParseConstraint("3.0")},
+			want: "1 || 2 || 3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.a.String(); got != tt.want {
+				t.Errorf("AnyOf.String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		c    Constrainter
+		want string
+	}{
+		{
+			name: "all_of_zero",
+			c:    AllOf{},
+			want: "*",
+		},
+		{
+			name: "any_of_zero",
+			c:    AnyOf{},
+			want: "[]",
+		},
+		{
+			name: "all_of_or",
+			c:    AllOf{MustParseConstraint("^1.0 || ^2.0"), MustParseConstraint(">=1.5")},
+			want: ">=1.5-dev <3-dev",
+		},
+		{
+			name: "all_of_ors",
+			c:    AllOf{MustParseConstraint("^1.0 || ^3.0"), MustParseConstraint("~1.2 || ~3.4")},
+			want: ">=1.2-dev <2-dev || >=3.4-dev <4-dev",
+		},
+		{
+			name: "all_of_not_equal",
+			c:    AllOf{MustParseConstraint("^1.0"), NewNotEqual(MustParse("1.5"))},
+			want: ">=1-dev <1.5 || >1.5 <2-dev",
+		},
+		{
+			name: "all_of_impossible",
+			c:    AllOf{MustParseConstraint("^1.0"), MustParseConstraint("^2.0")},
+			want: "[]",
+		},
+		{
+			name: "any_of_all_of",
+			c: AnyOf{
+				AllOf{MustParseConstraint("^1.0"), MustParseConstraint(">=1.5")},
+				AllOf{MustParseConstraint("^2.0"), MustParseConstraint("<2.5")},
+			},
+			want: ">=1.5-dev <2.5-dev",
+		},
+		{
+			name: "any_of_match_all",
+			c:    AnyOf{MustParseConstraint("^1.0"), NewMatchAll()},
+			want: "*",
+		},
+		{
+			name: "deeply_nested",
+			c: AllOf{
+				AnyOf{
+					AllOf{MustParseConstraint(">=1.0"), AnyOf{MustParseConstraint("<1.2"), MustParseConstraint(">=1.8")}},
+					MustParseConstraint("^3.0"),
+				},
+				MustParseConstraint("<3.5"),
+			},
+			want: ">=1-dev <1.2-dev || >=1.8-dev <3.5-dev",
+		},
+		{
+			name: "branch",
+			c:    AllOf{AnyOf{MustParseConstraint("dev-master"), MustParseConstraint("^1.0")}, MustParseConstraint("dev-master")},
+			want: "dev-master",
+		},
+		{
+			name: "flagged",
+			c:    AnyOf{MustParseConstraint("^1.0@beta"), AllOf{MustParseConstraint("^2.0@dev")}},
+			want: ">=1-dev <3-dev@dev",
+		},
+		{
+			name: "not_nested",
+			c:    NewNotEqual(MustParse("1.5")),
+			want: "!=1.5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Normalize(tt.c).String(); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.c, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntersect_nested(t *testing.T) {
+	t.Parallel()
+
+	a := AnyOf{MustParseConstraint("^1.0"), MustParseConstraint("^2.0")}
+	b := AllOf{MustParseConstraint(">=1.5"), NewNotEqual(MustParse("2.1"))}
+
+	want := ">=1.5-dev <2.1 || >2.1 <3-dev"
+
+	if got := Intersect(a, b).String(); got != want {
+		t.Errorf("Intersect(%q, %q) = %q, want %q", a, b, got, want)
+	}
+}
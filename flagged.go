@@ -0,0 +1,70 @@
+package comver
+
+import "regexp"
+
+var stabilityFlagRegexp = regexp.MustCompile(`(?i)([^,\s|]*)@(stable|rc|beta|alpha|dev)(?:$|[,\s|])`)
+
+// FlaggedConstraint represents a [Constrainter] with an explicit
+// [stability flag], e.g.: '^1.0@beta'.
+//
+// Stability flags do not affect which versions satisfy the constraint.
+// Instead, they override the minimum-stability for the package. It is up to
+// the callers to apply the overrides, e.g.: via [Stability.Allows].
+//
+// [stability flag]: https://getcomposer.org/doc/04-schema.md#package-links
+type FlaggedConstraint struct {
+	constraint Constrainter
+	stability  Stability
+}
+
+// Check reports whether a [Version] satisfies the constraint.
+// The stability flag is ignored.
+func (f FlaggedConstraint) Check(v Version) bool {
+	return f.constraint.Check(v)
+}
+
+func (f FlaggedConstraint) String() string {
+	return f.constraint.String() + "@" + f.stability.String()
+}
+
+// Constraint returns the [Constrainter] without the stability flag.
+func (f FlaggedConstraint) Constraint() Constrainter { //nolint:ireturn
+	return f.constraint
+}
+
+// Stability returns the stability flag.
+func (f FlaggedConstraint) Stability() Stability {
+	return f.stability
+}
+
+// stripStabilityFlags returns the constraint string with all stability flags
+// removed, and the least stable flag found. A lone flag, e.g.: '@dev', is
+// replaced with '*'.
+//
+// Same as composer, when multiple flags are given, the least stable one wins,
+// e.g.: '^1.0@beta || ^2.0@dev' results in dev.
+func stripStabilityFlags(s string) (string, Stability, bool) {
+	flag, found := StabilityStable, false
+
+	r := stabilityFlagRegexp.ReplaceAllStringFunc(s, func(m string) string {
+		sm := stabilityFlagRegexp.FindStringSubmatch(m)
+
+		// flags are from the fixed set, parsing never fails
+		st, _ := ParseStability(sm[2])
+		if !found || st < flag {
+			flag = st
+		}
+
+		found = true
+
+		c := sm[1]
+		if c == "" {
+			c = "*"
+		}
+
+		// keep the separator matched after the flag
+		return c + m[len(sm[1])+len("@")+len(sm[2]):]
+	})
+
+	return r, flag, found
+}
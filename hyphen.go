@@ -0,0 +1,35 @@
+package comver
+
+// NewHyphenRange returns a [CeilingFloorConstrainter] instance representing the
+// [hyphenated version range] between the given [Version] instances, e.g.
+// '1.0 - 2.0'; or return an error if the range could never be satisfied, or
+// either [Version] is a named dev branch.
+//
+// Same as composer, the range is inclusive. Partial upper bounds (i.e. without
+// patch component) are rounded up with pre-releases excluded, e.g.:
+//   - '1.0 - 2.0' means '>=1.0.0.0-dev <2.1.0.0-dev'
+//   - '1.0.0 - 2.1.0' means '>=1.0.0.0-dev <=2.1.0.0'
+//   - '1.0 - 2' means '>=1.0.0.0-dev <3.0.0.0-dev'
+//
+// Upper bounds with modifiers are always inclusive. For versions not coming from
+// [Parse], the number of numeric components in [Version.Short] is used.
+// Named dev branches, e.g. dev-master, have no ranges, same as [NewCaret],
+// [NewTilde] and [NewWildcard].
+//
+// [hyphenated version range]: https://getcomposer.org/doc/articles/versions.md#hyphenated-version-range-
+func NewHyphenRange(from, to Version) (CeilingFloorConstrainter, error) { //nolint:ireturn
+	if from.isBranch() || to.isBranch() {
+		var nilC CeilingFloorConstrainter
+
+		return nilC, errBranchInterval
+	}
+
+	floor := NewGreaterThanOrEqualTo(from.lowestPreRelease())
+
+	parts := to.parts()
+	if parts >= 3 || to.modifier != modifierStable {
+		return And(floor, NewLessThanOrEqualTo(to))
+	}
+
+	return And(floor, NewLessThan(to.bump(parts).lowestPreRelease()))
+}
@@ -0,0 +1,26 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleNewHyphenRange() {
+	partial, _ := comver.NewHyphenRange(comver.MustParse("1.0"), comver.MustParse("2.0"))
+	full, _ := comver.NewHyphenRange(comver.MustParse("1.0"), comver.MustParse("2.0.0"))
+
+	fmt.Println(partial)
+	fmt.Println(full)
+
+	// Output:
+	// >=1-dev <2.1-dev
+	// >=1-dev <=2
+}
+
+func ExampleNewHyphenRange_impossibleInterval() {
+	_, err := comver.NewHyphenRange(comver.MustParse("2.0"), comver.MustParse("1.0"))
+
+	fmt.Println(err)
+	// Output: impossible interval
+}
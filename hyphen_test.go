@@ -0,0 +1,88 @@
+package comver
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestNewHyphenRange(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		from    string
+		to      string
+		want    string
+		wantErr error
+	}{
+		// taken from composer/semver VersionParserTest::hyphenConstraints()
+		// https://github.com/composer/semver/blob/1d09200268e7d1052ded8e5da9c73c96a63d18f5/tests/VersionParserTest.php
+		{"1", "2", ">=1-dev <3-dev", nil},
+		{"1.2.3", "2.3.4.5", ">=1.2.3-dev <=2.3.4.5", nil},
+		{"1.2-beta", "2.3", ">=1.2-beta <2.4-dev", nil},
+		{"1.2-RC", "2.3.1", ">=1.2-RC <=2.3.1", nil},
+		{"1.2.3-alpha", "2.3-RC", ">=1.2.3-alpha <=2.3-RC", nil},
+		{"1", "2.0", ">=1-dev <2.1-dev", nil},
+		{"1", "2.1", ">=1-dev <2.2-dev", nil},
+		{"1.2", "2.1.0", ">=1.2-dev <=2.1", nil},
+		{"1.3", "2.1.3", ">=1.3-dev <=2.1.3", nil},
+
+		// additional tests
+		{"1.0", "1.0", ">=1-dev <1.1-dev", nil},
+		{"1.0.0", "1.0.0", ">=1-dev <=1", nil},
+		{"1.0-beta", "1.0-beta", "1-beta", nil},
+		{"2.0", "1.0", "", errImpossibleInterval},
+		{"2.0-beta", "1.0-beta", "", errImpossibleInterval},
+		{"dev-master", "1.0", "", errBranchInterval},
+		{"1.0", "dev-master", "", errBranchInterval},
+	}
+	for _, tt := range tests {
+		t.Run(tt.from+" - "+tt.to, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewHyphenRange(MustParse(tt.from), MustParse(tt.to))
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewHyphenRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if gotString := got.String(); gotString != tt.want {
+				t.Errorf("NewHyphenRange().String() = %q, want %q", gotString, tt.want)
+			}
+		})
+	}
+}
+
+func Test_joinHyphenRanges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		fs   []string
+		want []string
+	}{
+		{"empty", []string{}, []string{}},
+		{"no hyphen", []string{">1", "<2"}, []string{">1", "<2"}},
+		{"hyphen", []string{"1", "-", "2"}, []string{"1 - 2"}},
+		{"hyphen and", []string{">=1", "1", "-", "2", "<3"}, []string{">=1", "1 - 2", "<3"}},
+		{"multiple hyphens", []string{"1", "-", "2", "3", "-", "4"}, []string{"1 - 2", "3 - 4"}},
+		{"chained hyphens", []string{"1", "-", "2", "-", "3"}, []string{"1 - 2 - 3"}},
+		{"leading hyphen", []string{"-", "2"}, []string{"-", "2"}},
+		{"trailing hyphen", []string{"1", "-"}, []string{"1", "-"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := joinHyphenRanges(tt.fs)
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("joinHyphenRanges(%q) = %q, want %q", tt.fs, got, tt.want)
+			}
+		})
+	}
+}
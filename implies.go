@@ -0,0 +1,22 @@
+package comver
+
+// Implies reports whether every [Version] satisfying a also satisfies b, i.e.:
+// whether a is a subset of b. For example, '^2.3' implies '>=2.0 <3.0'.
+//
+// It is computed symbolically, i.e.: a AND NOT b could never be satisfied,
+// rather than by sampling versions. The ranges of numbered versions and the
+// named dev branches satisfying the constraints are compared separately.
+// Ranges bounded by named dev branches, e.g.: '>dev-master', are never
+// satisfied, thus they imply any constraint. Stability flags are ignored.
+func Implies(a, b Constrainter) bool {
+	return setOf(a).intersect(setOf(b).complement()).empty()
+}
+
+// Intersects reports whether any [Version] could satisfy both a and b at the
+// same time. For example, '^1.0' intersects '>=1.5 <3.0' but not '^2.0'.
+//
+// It is computed symbolically with named dev branches compared separately, see
+// [Implies] and [Intersect]. Stability flags are ignored.
+func Intersects(a, b Constrainter) bool {
+	return !setOf(a).intersect(setOf(b)).empty()
+}
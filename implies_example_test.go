@@ -0,0 +1,30 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleImplies() {
+	declared := comver.MustParseConstraint("^2.3")
+	approved := comver.MustParseConstraint(">=2.0 <3.0")
+
+	fmt.Println(comver.Implies(declared, approved))
+	fmt.Println(comver.Implies(approved, declared))
+
+	// Output:
+	// true
+	// false
+}
+
+func ExampleIntersects() {
+	a := comver.MustParseConstraint("^1.0")
+
+	fmt.Println(comver.Intersects(a, comver.MustParseConstraint(">=1.5 <3.0")))
+	fmt.Println(comver.Intersects(a, comver.MustParseConstraint("^2.0")))
+
+	// Output:
+	// true
+	// false
+}
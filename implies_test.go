@@ -0,0 +1,146 @@
+package comver
+
+import "testing"
+
+func TestImplies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a    Constrainter
+		b    Constrainter
+		want bool
+	}{
+		{MustParseConstraint("^2.3"), MustParseConstraint(">=2.0 <3.0"), true},
+		{MustParseConstraint(">=2.0 <3.0"), MustParseConstraint("^2.3"), false},
+		{MustParseConstraint("^2.3"), MustParseConstraint("^2.3"), true},
+		{MustParseConstraint("~2.3.1"), MustParseConstraint("^2.3"), true},
+		{MustParseConstraint("^2.3"), MustParseConstraint("~2.3.1"), false},
+		{MustParseConstraint("^1.0 || ^2.0"), MustParseConstraint(">=1.0 <3.0"), true},
+		{MustParseConstraint("^1.0 || ^3.0"), MustParseConstraint(">=1.0 <3.0"), false},
+		{MustParseConstraint("1.5"), MustParseConstraint("^1.0"), true},
+		{MustParseConstraint("^1.0"), MustParseConstraint("1.5"), false},
+		{MustParseConstraint("^1.0"), MustParseConstraint("!=2.0"), true},
+		{MustParseConstraint("^1.0"), MustParseConstraint("!=1.5"), false},
+		{MustParseConstraint("<=2.0"), MustParseConstraint("<2.0"), false},
+		{NewLessThan(MustParse("2")), NewLessThanOrEqualTo(MustParse("2")), true},
+		{NewGreaterThan(MustParse("2")), NewGreaterThanOrEqualTo(MustParse("2")), true},
+		{NewGreaterThanOrEqualTo(MustParse("2")), NewGreaterThan(MustParse("2")), false},
+		{MustParseConstraint("^1.0"), NewMatchAll(), true},
+		{NewMatchAll(), MustParseConstraint("^1.0"), false},
+		{NewMatchAll(), NewMatchAll(), true},
+		{Or{}, MustParseConstraint("^1.0"), true},
+		{Or{}, Or{}, true},
+		{MustParseConstraint("^1.0"), Or{}, false},
+		{MustParseConstraint("^1.0@beta"), MustParseConstraint(">=1.0"), true},
+		{MustParseConstraint("dev-master"), MustParseConstraint("dev-master || ^1.0"), true},
+		{MustParseConstraint(">dev-master"), NewMatchNone(), true},
+		{NewGreaterThan(MustParse("dev-master")), NewMatchNone(), true},
+		{NewGreaterThan(MustParse("dev-master")), NewGreaterThan(MustParse("dev-master")), true},
+		{MustParseConstraint(">dev-master"), MustParseConstraint("dev-master"), true},
+		{MustParseConstraint("dev-master"), MustParseConstraint(">dev-master"), false},
+		{MustParseConstraint("dev-master"), MustParseConstraint("^1.0"), false},
+		{MustParseConstraint("dev-master"), NewMatchAll(), true},
+		{MustParseConstraint("dev-master"), NewNotEqual(MustParse("dev-foo")), true},
+		{MustParseConstraint("dev-master"), NewNotEqual(MustParse("dev-master")), false},
+		{MustParseConstraint("^1.0"), NewNotEqual(MustParse("dev-master")), true},
+		{NewNotEqual(MustParse("dev-master")), NewMatchAll(), true},
+		{NewMatchAll(), NewNotEqual(MustParse("dev-master")), false},
+		{NewNotEqual(MustParse("dev-master")), MustParseConstraint("^1.0"), false},
+		{
+			AllOf{NewNotEqual(MustParse("dev-master")), NewNotEqual(MustParse("dev-foo"))},
+			NewNotEqual(MustParse("dev-master")),
+			true,
+		},
+		{
+			NewNotEqual(MustParse("dev-master")),
+			AllOf{NewNotEqual(MustParse("dev-master")), NewNotEqual(MustParse("dev-foo"))},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a.String()+"/"+tt.b.String(), func(t *testing.T) {
+			t.Parallel()
+
+			if got := Implies(tt.a, tt.b); got != tt.want {
+				t.Errorf("Implies(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImplies_reflexive(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c     string
+		empty bool
+	}{
+		{"^1.0", false},
+		{">=1.0 <1.1 || >=1.2", false},
+		{"!=1.5", false},
+		{"!=1.5 !=dev-master", false},
+		{"*", false},
+		{"dev-master", false},
+		{"dev-master || ^1.0", false},
+		{"^1.0@beta", false},
+		{">dev-master", true},
+		{"<dev-master", true},
+		{">master", true},
+		{">dev-1", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.c, func(t *testing.T) {
+			t.Parallel()
+
+			x := MustParseConstraint(tt.c)
+
+			if got := Implies(x, x); !got {
+				t.Errorf("Implies(%q, %q) = %v, want %v", tt.c, tt.c, got, true)
+			}
+
+			if got := Implies(x, NewMatchNone()); got != tt.empty {
+				t.Errorf("Implies(%q, MatchNone) = %v, want %v", tt.c, got, tt.empty)
+			}
+		})
+	}
+}
+
+func TestIntersects(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a    Constrainter
+		b    Constrainter
+		want bool
+	}{
+		{MustParseConstraint("^1.0"), MustParseConstraint(">=1.5 <3.0"), true},
+		{MustParseConstraint("^1.0"), MustParseConstraint("^2.0"), false},
+		{MustParseConstraint("^1.0 || ^3.0"), MustParseConstraint("^2.0 || ^3.5"), true},
+		{MustParseConstraint("^1.0 || ^3.0"), MustParseConstraint("^2.0 || ^4.0"), false},
+		{NewLessThanOrEqualTo(MustParse("2")), NewGreaterThanOrEqualTo(MustParse("2")), true},
+		{NewLessThan(MustParse("2")), NewGreaterThanOrEqualTo(MustParse("2")), false},
+		{MustParseConstraint("1.5"), MustParseConstraint("!=1.5"), false},
+		{MustParseConstraint("1.5"), MustParseConstraint("!=1.6"), true},
+		{NewMatchAll(), MustParseConstraint("^1.0"), true},
+		{Or{}, MustParseConstraint("^1.0"), false},
+		{Or{}, NewMatchAll(), false},
+		{MustParseConstraint("dev-master"), MustParseConstraint("dev-master || ^1.0"), true},
+		{MustParseConstraint("dev-master"), MustParseConstraint("^1.0"), false},
+		{MustParseConstraint("dev-master"), NewNotEqual(MustParse("dev-master")), false},
+		{NewNotEqual(MustParse("dev-master")), NewNotEqual(MustParse("dev-foo")), true},
+		{MustParseConstraint("^1.0@dev"), MustParseConstraint("^1.5@beta"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a.String()+"/"+tt.b.String(), func(t *testing.T) {
+			t.Parallel()
+
+			if got := Intersects(tt.a, tt.b); got != tt.want {
+				t.Errorf("Intersects(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+
+			if got := Intersects(tt.b, tt.a); got != tt.want {
+				t.Errorf("Intersects(%q, %q) = %v, want %v", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
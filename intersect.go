@@ -0,0 +1,18 @@
+package comver
+
+// Intersect returns a [Constrainter] instance representing the logical AND of
+// the given [Constrainter] instances.
+//
+// Intersect distributes over [Or], i.e.: '(^1.0 || ^2.0) AND >=1.5' becomes
+// '(^1.0 AND >=1.5) || (^2.0 AND >=1.5)', and drops the intersections that
+// could never be satisfied. The result is [Compact]-ed. When a and b could
+// never be satisfied at the same time, [MatchNone] is returned.
+//
+// Same as [ParseConstraint], intersecting [NotEqual] instances without ranges
+// results in an [AllOf] of them, e.g.: '!=1.0 !=dev-master'.
+//
+// When either a or b is a [FlaggedConstraint], the result is a
+// [FlaggedConstraint] with the least stable flag.
+func Intersect(a, b Constrainter) Constrainter { //nolint:ireturn
+	return flagged(setOf(a).intersect(setOf(b)).constraint(), a, b)
+}
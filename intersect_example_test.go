@@ -0,0 +1,31 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleIntersect() {
+	a := comver.MustParseConstraint("^1.0 || ^2.0")
+	b := comver.MustParseConstraint(">=1.5")
+
+	c := comver.Intersect(a, b)
+
+	fmt.Println(c)
+	// Output: >=1.5-dev <3-dev
+}
+
+func ExampleIntersect_matchNone() {
+	a := comver.MustParseConstraint("^1.0")
+	b := comver.MustParseConstraint("^2.0")
+
+	c := comver.Intersect(a, b)
+
+	fmt.Printf("%q\n", c)
+	fmt.Println(c.Check(comver.MustParse("1.5")))
+
+	// Output:
+	// "[]"
+	// false
+}
@@ -0,0 +1,204 @@
+package comver
+
+import "testing"
+
+func TestIntersect(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		a    Constrainter
+		b    Constrainter
+		want string
+	}{
+		{
+			name: "or_and_endless",
+			a:    MustParseConstraint("^1.0 || ^2.0"),
+			b:    MustParseConstraint(">=1.5"),
+			want: ">=1.5-dev <3-dev",
+		},
+		{
+			name: "or_and_interval",
+			a:    MustParseConstraint("^1.0 || ^2.0 || ^3.0"),
+			b:    MustParseConstraint(">=1.5 <2.5"),
+			want: ">=1.5-dev <2.5-dev",
+		},
+		{
+			name: "or_and_or",
+			a:    MustParseConstraint("^1.0 || ^3.0"),
+			b:    MustParseConstraint("~1.2 || ~3.4"),
+			want: ">=1.2-dev <2-dev || >=3.4-dev <4-dev",
+		},
+		{
+			name: "disjoint",
+			a:    MustParseConstraint("^1.0"),
+			b:    MustParseConstraint("^2.0"),
+			want: "[]",
+		},
+		{
+			name: "disjoint_or",
+			a:    MustParseConstraint("^1.0 || ^3.0"),
+			b:    MustParseConstraint("^2.0 || ^4.0"),
+			want: "[]",
+		},
+		{
+			name: "touching_inclusive",
+			a:    NewLessThanOrEqualTo(MustParse("2")),
+			b:    NewGreaterThanOrEqualTo(MustParse("2")),
+			want: "2",
+		},
+		{
+			name: "touching_exclusive",
+			a:    MustParseConstraint("<=2.0"),
+			b:    MustParseConstraint(">2.0"),
+			want: "[]",
+		},
+		{
+			name: "exact",
+			a:    MustParseConstraint("1.5"),
+			b:    MustParseConstraint("^1.0"),
+			want: "1.5",
+		},
+		{
+			name: "match_all",
+			a:    NewMatchAll(),
+			b:    MustParseConstraint("^1.0 || ^2.0"),
+			want: ">=1-dev <3-dev",
+		},
+		{
+			name: "match_none",
+			a:    Or{},
+			b:    MustParseConstraint("^1.0"),
+			want: "[]",
+		},
+		{
+			name: "not_equal",
+			a:    NewNotEqual(MustParse("1.5")),
+			b:    MustParseConstraint("^1.0"),
+			want: ">=1-dev <1.5 || >1.5 <2-dev",
+		},
+		{
+			name: "not_equal_reversed",
+			a:    MustParseConstraint("^1.0"),
+			b:    NewNotEqual(MustParse("1.5")),
+			want: ">=1-dev <1.5 || >1.5 <2-dev",
+		},
+		{
+			name: "not_equal_not_equal",
+			a:    NewNotEqual(MustParse("1.5")),
+			b:    NewNotEqual(MustParse("2.5")),
+			want: "!=1.5 !=2.5",
+		},
+		{
+			name: "branch",
+			a:    MustParseConstraint("dev-master || ^1.0"),
+			b:    MustParseConstraint("dev-master || dev-foo"),
+			want: "dev-master",
+		},
+		{
+			name: "branch_and_range",
+			a:    MustParseConstraint("dev-master"),
+			b:    MustParseConstraint(">=1.0"),
+			want: "[]",
+		},
+		{
+			name: "branch_and_match_all",
+			a:    MustParseConstraint("dev-master"),
+			b:    NewMatchAll(),
+			want: "dev-master",
+		},
+		{
+			name: "branch_not_equal",
+			a:    MustParseConstraint("dev-master || dev-foo"),
+			b:    NewNotEqual(MustParse("dev-master")),
+			want: "dev-foo",
+		},
+		{
+			name: "branch_not_equal_match_all",
+			a:    NewNotEqual(MustParse("dev-master")),
+			b:    NewMatchAll(),
+			want: "!=dev-master",
+		},
+		{
+			name: "branch_not_equal_not_equal",
+			a:    NewNotEqual(MustParse("dev-master")),
+			b:    NewNotEqual(MustParse("1.5")),
+			want: "!=1.5 !=dev-master",
+		},
+		{
+			name: "branch_not_equals",
+			a:    NewNotEqual(MustParse("dev-master")),
+			b:    NewNotEqual(MustParse("dev-foo")),
+			want: "!=dev-foo !=dev-master",
+		},
+		{
+			name: "branch_not_equals_same",
+			a:    NewNotEqual(MustParse("dev-master")),
+			b:    NewNotEqual(MustParse("dev-master")),
+			want: "!=dev-master",
+		},
+		{
+			name: "branch_not_equals_range",
+			a:    AllOf{NewNotEqual(MustParse("dev-master")), NewNotEqual(MustParse("dev-foo"))},
+			b:    MustParseConstraint("^1.0 || dev-foo || dev-bar"),
+			want: ">=1-dev <2-dev || dev-bar",
+		},
+		{
+			name: "flagged",
+			a:    MustParseConstraint("^1.0@beta"),
+			b:    MustParseConstraint(">=1.5"),
+			want: ">=1.5-dev <2-dev@beta",
+		},
+		{
+			name: "flagged_both",
+			a:    MustParseConstraint("^1.0@beta"),
+			b:    MustParseConstraint(">=1.5@dev"),
+			want: ">=1.5-dev <2-dev@dev",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Intersect(tt.a, tt.b).String(); got != tt.want {
+				t.Errorf("Intersect(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+			}
+
+			if got := Intersect(tt.b, tt.a).String(); got != tt.want {
+				t.Errorf("Intersect(%q, %q) = %q, want %q", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntersect_Check(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a    string
+		b    string
+		v    string
+		want bool
+	}{
+		{"^1.0 || ^2.0", ">=1.5", "1.4", false},
+		{"^1.0 || ^2.0", ">=1.5", "1.5", true},
+		{"^1.0 || ^2.0", ">=1.5", "2.9", true},
+		{"^1.0 || ^2.0", ">=1.5", "3.0", false},
+		{"^1.0 || ^2.0", "!=2.1", "2.1", false},
+		{"^1.0 || ^2.0", "!=2.1", "2.2", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b+"/"+tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			a, b := MustParseConstraint(tt.a), MustParseConstraint(tt.b)
+			v := MustParse(tt.v)
+
+			got := Intersect(a, b).Check(v)
+
+			if want := a.Check(v) && b.Check(v); got != want || got != tt.want {
+				t.Errorf("Intersect(%q, %q).Check(%q) = %v, want %v", tt.a, tt.b, tt.v, got, tt.want)
+			}
+		})
+	}
+}
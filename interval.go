@@ -1,25 +1,94 @@
 package comver
 
-// interval represents a constraint that is both floor bounded and ceiling
-// bounded. It must be initialized via [And].
-type interval struct {
+const (
+	errSingleVersionInterval stringError = "interval of a single version"
+	errBranchInterval        stringError = "named dev branches could not bound an interval"
+)
+
+// Interval represents a constraint that is both floor bounded and ceiling
+// bounded, e.g.: '>=1.0 <2.0'. It must be initialized via [NewInterval] or
+// [And]. The zero value for Interval is unbounded, i.e.: a match all '*'.
+type Interval struct {
 	upper Endless
 	lower Endless
 }
 
+// NewInterval returns an [Interval] from the lower version to the upper
+// version, e.g.: '[1.0, 2.0)' when lowerInclusive is true and upperInclusive
+// is false; or return an [*IntervalError] if the versions could not bound an
+// interval, i.e.:
+//   - the lower version is greater than the upper version
+//   - both versions are the same, use [NewExactConstraint] instead
+//   - either version is a named dev branch, which is not comparable with ranges
+func NewInterval(lower, upper Version, lowerInclusive, upperInclusive bool) (Interval, error) {
+	if lower.isBranch() || upper.isBranch() {
+		return Interval{}, &IntervalError{lower, upper, errBranchInterval}
+	}
+
+	switch cmp := lower.Compare(upper); {
+	case cmp > 0:
+		return Interval{}, &IntervalError{lower, upper, errImpossibleInterval}
+	case cmp == 0 && lowerInclusive && upperInclusive:
+		return Interval{}, &IntervalError{lower, upper, errSingleVersionInterval}
+	case cmp == 0:
+		return Interval{}, &IntervalError{lower, upper, errImpossibleInterval}
+	}
+
+	i := Interval{
+		upper: NewLessThan(upper),
+		lower: NewGreaterThan(lower),
+	}
+
+	if upperInclusive {
+		i.upper = NewLessThanOrEqualTo(upper)
+	}
+
+	if lowerInclusive {
+		i.lower = NewGreaterThanOrEqualTo(lower)
+	}
+
+	return i, nil
+}
+
+// MustNewInterval is like [NewInterval] but panics if an error occurs.
+func MustNewInterval(lower, upper Version, lowerInclusive, upperInclusive bool) Interval {
+	i, err := NewInterval(lower, upper, lowerInclusive, upperInclusive)
+	if err != nil {
+		panic(err)
+	}
+
+	return i
+}
+
 // Check reports whether a [Version] satisfies the constraint.
-func (i interval) Check(v Version) bool {
+func (i Interval) Check(v Version) bool {
 	return i.ceiling().Check(v) && i.floor().Check(v)
 }
 
-func (i interval) String() string {
+func (i Interval) String() string {
+	if matchAll(i) {
+		return "*"
+	}
+
 	return i.floor().String() + " " + i.ceiling().String()
 }
 
-func (i interval) ceiling() Endless {
+// Lower returns the lower bound of the constraint, e.g.: inclusive 1.0 for
+// '>=1.0 <2.0'.
+func (i Interval) Lower() Bound {
+	return newBound(i.floor())
+}
+
+// Upper returns the upper bound of the constraint, e.g.: exclusive 2.0 for
+// '>=1.0 <2.0'.
+func (i Interval) Upper() Bound {
+	return newBound(i.ceiling())
+}
+
+func (i Interval) ceiling() Endless {
 	return i.upper
 }
 
-func (i interval) floor() Endless {
+func (i Interval) floor() Endless {
 	return i.lower
 }
@@ -0,0 +1,27 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleNewInterval() {
+	i, _ := comver.NewInterval(comver.MustParse("1.0"), comver.MustParse("2.0"), true, false)
+
+	fmt.Println(i)
+	fmt.Println(i.Check(comver.MustParse("1.5")))
+	fmt.Println(i.Check(comver.MustParse("2.0")))
+
+	// Output:
+	// >=1 <2
+	// true
+	// false
+}
+
+func ExampleNewInterval_error() {
+	_, err := comver.NewInterval(comver.MustParse("2.0"), comver.MustParse("1.0"), true, false)
+
+	fmt.Println(err)
+	// Output: error creating interval from "2" to "1"
+}
@@ -0,0 +1,139 @@
+package comver
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewInterval(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		lower          string
+		upper          string
+		lowerInclusive bool
+		upperInclusive bool
+		want           string
+	}{
+		{"1", "2", true, false, ">=1 <2"},
+		{"1", "2", false, true, ">1 <=2"},
+		{"1", "2", true, true, ">=1 <=2"},
+		{"1", "2", false, false, ">1 <2"},
+		{"1.0.0-dev", "2.0.0-dev", true, false, ">=1-dev <2-dev"},
+		{"1.0.0-beta", "1.0.0", true, false, ">=1-beta <1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewInterval(MustParse(tt.lower), MustParse(tt.upper), tt.lowerInclusive, tt.upperInclusive)
+			if err != nil {
+				t.Fatalf("NewInterval() error = %v", err)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("NewInterval() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewInterval_IntervalError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		lower          string
+		upper          string
+		lowerInclusive bool
+		upperInclusive bool
+		wantErr        error
+	}{
+		{"reversed", "2", "1", true, true, errImpossibleInterval},
+		{"reversed_pre_release", "1", "1.0.0-beta", true, true, errImpossibleInterval},
+		{"same_exclusive", "1", "1", false, false, errImpossibleInterval},
+		{"same_lower_exclusive", "1", "1", false, true, errImpossibleInterval},
+		{"same_upper_exclusive", "1", "1", true, false, errImpossibleInterval},
+		{"same_inclusive", "1", "1.0.0.0", true, true, errSingleVersionInterval},
+		{"lower_branch", "dev-master", "2", true, false, errBranchInterval},
+		{"upper_branch", "1", "dev-master", true, false, errBranchInterval},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			lower, upper := MustParse(tt.lower), MustParse(tt.upper)
+
+			_, err := NewInterval(lower, upper, tt.lowerInclusive, tt.upperInclusive)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewInterval() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			var wantIntervalError *IntervalError
+			if !errors.As(err, &wantIntervalError) {
+				t.Fatalf("NewInterval() error = %#v, wantErr %#v", err, wantIntervalError)
+			}
+
+			if wantIntervalError.Lower().Compare(lower) != 0 {
+				t.Errorf("NewInterval() error.Lower() = %v, want %v", wantIntervalError.Lower(), lower)
+			}
+
+			if wantIntervalError.Upper().Compare(upper) != 0 {
+				t.Errorf("NewInterval() error.Upper() = %v, want %v", wantIntervalError.Upper(), upper)
+			}
+		})
+	}
+}
+
+func TestMustNewInterval_panic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("MustNewInterval() did not panic")
+		}
+	}()
+
+	MustNewInterval(MustParse("2"), MustParse("1"), true, true)
+}
+
+func TestInterval_algebra(t *testing.T) {
+	t.Parallel()
+
+	a := MustNewInterval(MustParse("1"), MustParse("2"), true, false)
+	b := MustNewInterval(MustParse("1.5"), MustParse("3"), true, false)
+	c := MustNewInterval(MustParse("5"), MustParse("6"), false, true)
+
+	if got, want := Compact(Or{a, b, c}).String(), ">=1 <3 || >5 <=6"; got != want {
+		t.Errorf("Compact() = %q, want %q", got, want)
+	}
+
+	if got, want := Intersect(a, b).String(), ">=1.5 <2"; got != want {
+		t.Errorf("Intersect() = %q, want %q", got, want)
+	}
+
+	if !Equal(a, MustAnd(a.floor(), a.ceiling())) {
+		t.Errorf("Equal(%q, And(%q)) = false, want true", a, a)
+	}
+}
+
+func TestInterval_zero(t *testing.T) {
+	t.Parallel()
+
+	var i Interval
+
+	if got, want := i.String(), "*"; got != want {
+		t.Errorf("Interval{}.String() = %q, want %q", got, want)
+	}
+
+	for _, v := range []string{"1", "1.0-beta", "dev-master"} {
+		if !i.Check(MustParse(v)) {
+			t.Errorf("Interval{}.Check(%q) = false, want true", v)
+		}
+	}
+
+	if !IsMatchAll(i) {
+		t.Errorf("IsMatchAll(Interval{}) = false, want true")
+	}
+}
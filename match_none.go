@@ -0,0 +1,55 @@
+package comver
+
+// MatchNone represents a [match none] constraint which could never be
+// satisfied. It is logically equivalent to the zero value [Or].
+//
+// [match none]: https://github.com/composer/semver/blob/main/src/Constraint/MatchNoneConstraint.php
+type MatchNone struct{}
+
+func NewMatchNone() MatchNone {
+	return MatchNone{}
+}
+
+// Check reports whether a [Version] satisfies the constraint, which is always
+// false.
+func (MatchNone) Check(Version) bool {
+	return false
+}
+
+// String returns '[]', same as composer.
+func (MatchNone) String() string {
+	return "[]"
+}
+
+// AndOrMatchNone is like [And] but returns a [MatchNone] instead of an error
+// when the given [Endless] instances could never be satisfied at the same
+// time, so that it could be chained without error handling, see [Intersect].
+// When no [Endless] is given, a match all is returned.
+func AndOrMatchNone(es ...Endless) Constrainter { //nolint:ireturn
+	if len(es) == 0 {
+		return NewMatchAll()
+	}
+
+	c, err := And(es...)
+	if err != nil {
+		return NewMatchNone()
+	}
+
+	return c
+}
+
+// IsEmpty reports whether the [Constrainter] could never be satisfied by any
+// [Version], e.g.: [MatchNone], the zero value [Or], '^1.0 ^2.0' and ranges
+// bounded by named dev branches, e.g.: '>dev-master'. Stability flags are
+// ignored.
+func IsEmpty(c Constrainter) bool {
+	return setOf(c).empty()
+}
+
+// IsMatchAll reports whether the [Constrainter] is satisfied by any [Version],
+// e.g.: [NewMatchAll], '*' and '!=1.0 || 1.0'. Ranges are never satisfied by
+// named dev branches, thus '<1.0 || >=1.0' is not a match all. Stability flags
+// are ignored.
+func IsMatchAll(c Constrainter) bool {
+	return setOf(c).full()
+}
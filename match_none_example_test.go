@@ -0,0 +1,32 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleAndOrMatchNone() {
+	c := comver.AndOrMatchNone(
+		comver.NewGreaterThanOrEqualTo(comver.MustParse("2")),
+		comver.NewLessThan(comver.MustParse("1")),
+	)
+
+	fmt.Println(c)
+	fmt.Println(comver.IsEmpty(c))
+
+	// Output:
+	// []
+	// true
+}
+
+func ExampleIsMatchAll() {
+	c := comver.MustParseConstraint("!=1.0 || 1.0")
+	d := comver.MustParseConstraint("<1.0 || >=1.0")
+
+	fmt.Println(comver.IsMatchAll(c))
+	fmt.Println(comver.IsMatchAll(d))
+	// Output:
+	// true
+	// false
+}
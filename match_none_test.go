@@ -0,0 +1,167 @@
+package comver
+
+import "testing"
+
+func TestMatchNone_Check(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"0", "1.0.0-dev", "1.2.3", "dev-master"} {
+		t.Run(v, func(t *testing.T) {
+			t.Parallel()
+
+			if got := NewMatchNone().Check(MustParse(v)); got {
+				t.Errorf("MatchNone.Check(%q) = %v, want false", v, got)
+			}
+		})
+	}
+}
+
+func TestAndOrMatchNone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		es   []Endless
+		want string
+	}{
+		{
+			name: "none",
+			es:   nil,
+			want: "*",
+		},
+		{
+			name: "match_all",
+			es:   []Endless{NewMatchAll()},
+			want: "*",
+		},
+		{
+			name: "interval",
+			es:   []Endless{NewGreaterThanOrEqualTo(MustParse("1")), NewLessThan(MustParse("2"))},
+			want: ">=1 <2",
+		},
+		{
+			name: "exact",
+			es:   []Endless{NewGreaterThanOrEqualTo(MustParse("1")), NewLessThanOrEqualTo(MustParse("1"))},
+			want: "1",
+		},
+		{
+			name: "impossible",
+			es:   []Endless{NewGreaterThanOrEqualTo(MustParse("2")), NewLessThan(MustParse("1"))},
+			want: "[]",
+		},
+		{
+			name: "impossible_exclusive",
+			es:   []Endless{NewGreaterThan(MustParse("1")), NewLessThanOrEqualTo(MustParse("1"))},
+			want: "[]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := AndOrMatchNone(tt.es...).String(); got != tt.want {
+				t.Errorf("AndOrMatchNone() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsEmpty(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    Constrainter
+		want bool
+	}{
+		{NewMatchNone(), true},
+		{Or{}, true},
+		{AnyOf{}, true},
+		{AllOf{NewMatchNone()}, true},
+		{AllOf{MustParseConstraint("^1.0"), MustParseConstraint("^2.0")}, true},
+		{FlaggedConstraint{Or{}, StabilityDev}, true},
+		{Intersect(MustParseConstraint("^1.0"), MustParseConstraint("^2.0")), true},
+		{AndOrMatchNone(NewGreaterThanOrEqualTo(MustParse("2")), NewLessThan(MustParse("1"))), true},
+		{MustParseConstraint(">dev-master"), true},
+		{NewGreaterThan(MustParse("dev-master")), true},
+		{Or{NewLessThan(MustParse("dev-master")), NewGreaterThan(MustParse("master"))}, true},
+		{AllOf{NewNotEqual(MustParse("dev-master")), MustParseConstraint("dev-master")}, true},
+		{NewMatchAll(), false},
+		{AllOf{}, false},
+		{MustParseConstraint("^1.0"), false},
+		{MustParseConstraint("1.0"), false},
+		{NewNotEqual(MustParse("1.0")), false},
+		{NewNotEqual(MustParse("dev-master")), false},
+		{MustParseConstraint("dev-master"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.c.String(), func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsEmpty(tt.c); got != tt.want {
+				t.Errorf("IsEmpty(%q) = %v, want %v", tt.c, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsMatchAll(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    Constrainter
+		want bool
+	}{
+		{NewMatchAll(), true},
+		{MustParseConstraint("*"), true},
+		{MustParseConstraint("*@dev"), true},
+		{MustParseConstraint("!=1.0 || 1.0"), true},
+		{MustParseConstraint("!=dev-master || dev-master"), true},
+		{MustParseConstraint("<1.0 || >=1.0"), false},
+		{Or{NewLessThan(MustParse("1")), NewGreaterThanOrEqualTo(MustParse("1"))}, false},
+		{Or{NewMatchAll(), NewLessThan(MustParse("1"))}, true},
+		{AllOf{}, true},
+		{AnyOf{MustParseConstraint("^1.0"), NewMatchAll()}, true},
+		{Not(NewMatchNone()), true},
+		{NewMatchNone(), false},
+		{Or{}, false},
+		{Or{NewLessThan(MustParse("1")), NewGreaterThan(MustParse("1"))}, false},
+		{MustParseConstraint("^1.0"), false},
+		{NewNotEqual(MustParse("1.0")), false},
+		{NewNotEqual(MustParse("dev-master")), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.c.String(), func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsMatchAll(tt.c); got != tt.want {
+				t.Errorf("IsMatchAll(%q) = %v, want %v", tt.c, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchNone_algebra(t *testing.T) {
+	t.Parallel()
+
+	c := MustParseConstraint("^1.0")
+
+	if got := Intersect(NewMatchNone(), c); !IsEmpty(got) {
+		t.Errorf("Intersect(MatchNone, %q) = %q, want empty", c, got)
+	}
+
+	if got := Normalize(AnyOf{NewMatchNone(), c}).String(); got != c.String() {
+		t.Errorf("Normalize(AnyOf{MatchNone, %q}) = %q, want %q", c, got, c)
+	}
+
+	if got := Not(NewMatchNone()).String(); got != "*" {
+		t.Errorf("Not(MatchNone) = %q, want %q", got, "*")
+	}
+
+	if got := Canonical(NewMatchNone()); got != NewMatchNone() {
+		t.Errorf("Canonical(MatchNone) = %q, want %q", got, NewMatchNone())
+	}
+
+	if !Equal(NewMatchNone(), Or{}) {
+		t.Errorf("Equal(MatchNone, Or{}) = false, want true")
+	}
+}
@@ -8,6 +8,7 @@ const (
 	modifierRC            modifier    = -10
 	modifierBeta          modifier    = -20
 	modifierAlpha         modifier    = -30
+	modifierDev           modifier    = -40
 	errUnexpectedModifier stringError = "unexpected modifier"
 )
 
@@ -38,6 +39,8 @@ func (s modifier) String() string {
 		return "beta"
 	case modifierAlpha:
 		return "alpha"
+	case modifierDev:
+		return "dev"
 	case modifierStable:
 		return ""
 	default:
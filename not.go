@@ -0,0 +1,20 @@
+package comver
+
+// Not returns a [Constrainter] instance representing the logical NOT of the
+// given [Constrainter], i.e.: the complement.
+//
+// Floor bounds and ceiling bounds are flipped, e.g.: '>=1.0' becomes '<1.0'
+// and '<=2.0' becomes '>2.0'. Intervals are split into two half-lines, e.g.:
+// '>=1.0 <2.0' becomes '<1.0 || >=2.0'. The result is [Compact]-ed. The
+// complement of a match all is [MatchNone], and vice versa. The complement of an [ExactConstraint] is a [NotEqual], and vice
+// versa.
+//
+// Composer could not express all named dev branches without all numbered
+// versions. Thus, the complement of a range, e.g.: '>=1.0', is approximated
+// by the flipped ranges without any named dev branches, e.g.: '<1.0'.
+//
+// When the given [Constrainter] is a [FlaggedConstraint], the result is a
+// [FlaggedConstraint] with the same flag.
+func Not(c Constrainter) Constrainter { //nolint:ireturn
+	return flagged(setOf(c).complement().constraint(), c)
+}
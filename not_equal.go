@@ -0,0 +1,53 @@
+package comver
+
+// NotEqual represents a constraint that is satisfied by any [Version] except
+// the given one, including named dev branches, e.g.: '!=1.5' is satisfied by
+// dev-master.
+//
+// NotEqual is not a [CeilingFloorConstrainter] because it has no single floor
+// and ceiling, and ranges are never satisfied by named dev branches. Thus, it
+// could not be put into an [Or] nor [Compact]-ed without changing its meaning.
+// Combine it via [AllOf], [AnyOf], [Intersect] or [NotEqual.And] instead,
+// which keep named dev branches apart from ranges; or use [NotEqual.Or] for
+// numbered versions only.
+type NotEqual struct {
+	version Version
+}
+
+func NewNotEqual(v Version) NotEqual {
+	return NotEqual{
+		version: v,
+	}
+}
+
+// Check reports whether a [Version] satisfies the constraint.
+func (n NotEqual) Check(v Version) bool {
+	return n.version.Compare(v) != 0
+}
+
+func (n NotEqual) String() string {
+	return "!=" + n.version.Short()
+}
+
+// Or returns the [Or] instance satisfied by the same numbered versions, i.e.
+// '<v || >v'. Unlike the constraint, the returned [Or] is not satisfied by any
+// named dev branches.
+func (n NotEqual) Or() Or {
+	return Or{
+		NewLessThan(n.version),
+		NewGreaterThan(n.version),
+	}
+}
+
+// And returns a [Constrainter] instance representing the logical AND of the
+// constraint and the given [CeilingFloorConstrainter], i.e. punching a hole
+// into c. The result is [Compact]-ed.
+//
+// When c is a [match all], the constraint itself is returned. When c could
+// only be satisfied by the version of the constraint, [MatchNone] is
+// returned.
+//
+// [match all]: https://github.com/composer/semver/blob/main/src/Constraint/MatchAllConstraint.php
+func (n NotEqual) And(c CeilingFloorConstrainter) Constrainter { //nolint:ireturn
+	return setOf(n).intersect(setOf(c)).constraint()
+}
@@ -0,0 +1,29 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleNotEqual_And() {
+	n := comver.NewNotEqual(comver.MustParse("1.5"))
+	c := comver.MustAnd(
+		comver.NewGreaterThanOrEqualTo(comver.MustParse("1")),
+		comver.NewLessThan(comver.MustParse("2")),
+	)
+
+	fmt.Println(n.And(c))
+	// Output: >=1 <1.5 || >1.5 <2
+}
+
+func ExampleNotEqual_Or() {
+	n := comver.NewNotEqual(comver.MustParse("1.5"))
+
+	fmt.Println(n)
+	fmt.Println(n.Or())
+
+	// Output:
+	// !=1.5
+	// <1.5 || >1.5
+}
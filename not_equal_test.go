@@ -0,0 +1,192 @@
+package comver
+
+import "testing"
+
+func TestNotEqual_Check(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n    string
+		v    string
+		want bool
+	}{
+		{"1.2.3", "1.2.3", false},
+		{"1.2.3", "1.2.3.0", false},
+		{"1.2.3", "1.2.3+foo", false},
+		{"1.2.3", "1.2.2", true},
+		{"1.2.3", "1.2.4", true},
+		{"1.2.3", "1.2.3-beta", true},
+		{"1.2.3", "1.2.3-patch1", true},
+		{"1.2.3-beta", "1.2.3-beta", false},
+		{"1.2.3-beta", "1.2.3-beta1", true},
+		{"dev-master", "dev-master", false},
+		{"dev-master", "dev-foo", true},
+		{"dev-master", "1", true},
+		{"1.2.3", "dev-master", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.n+"/"+tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			n := NewNotEqual(MustParse(tt.n))
+
+			if got := n.Check(MustParse(tt.v)); got != tt.want {
+				t.Errorf("NotEqual(%q).Check(%q) = %v, want %v", tt.n, tt.v, got, tt.want)
+			}
+
+			if n.version.isBranch() {
+				return
+			}
+
+			// ranges are never satisfied by named dev branches
+			want := tt.want && !MustParse(tt.v).isBranch()
+
+			if got := n.Or().Check(MustParse(tt.v)); got != want {
+				t.Errorf("NotEqual(%q).Or().Check(%q) = %v, want %v", tt.n, tt.v, got, want)
+			}
+		})
+	}
+}
+
+func TestNotEqual_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v    string
+		want string
+	}{
+		{"1", "!=1"},
+		{"1.2.3.0", "!=1.2.3"},
+		{"1.2.3.4-beta5", "!=1.2.3.4-beta5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			n := NewNotEqual(MustParse(tt.v))
+
+			if got := n.String(); got != tt.want {
+				t.Errorf("NotEqual(%q).String() = %q, want %q", tt.v, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNotEqual_And(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		n    string
+		c    CeilingFloorConstrainter
+		want string
+	}{
+		{
+			name: "match_all",
+			n:    "2",
+			c:    NewMatchAll(),
+			want: "!=2",
+		},
+		{
+			name: "branch_match_all",
+			n:    "dev-master",
+			c:    NewMatchAll(),
+			want: "!=dev-master",
+		},
+		{
+			name: "branch_exact",
+			n:    "dev-master",
+			c:    NewExactConstraint(MustParse("dev-master")),
+			want: "[]",
+		},
+		{
+			name: "branch_exact_different",
+			n:    "dev-master",
+			c:    NewExactConstraint(MustParse("dev-foo")),
+			want: "dev-foo",
+		},
+		{
+			name: "branch_interval",
+			n:    "dev-master",
+			c:    MustAnd(NewGreaterThanOrEqualTo(MustParse("1")), NewLessThan(MustParse("3"))),
+			want: ">=1 <3",
+		},
+		{
+			name: "interval",
+			n:    "2",
+			c:    MustAnd(NewGreaterThanOrEqualTo(MustParse("1")), NewLessThan(MustParse("3"))),
+			want: ">=1 <2 || >2 <3",
+		},
+		{
+			name: "interval_floor",
+			n:    "1",
+			c:    MustAnd(NewGreaterThanOrEqualTo(MustParse("1")), NewLessThan(MustParse("3"))),
+			want: ">1 <3",
+		},
+		{
+			name: "interval_ceiling",
+			n:    "3",
+			c:    MustAnd(NewGreaterThanOrEqualTo(MustParse("1")), NewLessThanOrEqualTo(MustParse("3"))),
+			want: ">=1 <3",
+		},
+		{
+			name: "interval_outside",
+			n:    "5",
+			c:    MustAnd(NewGreaterThanOrEqualTo(MustParse("1")), NewLessThan(MustParse("3"))),
+			want: ">=1 <3",
+		},
+		{
+			name: "interval_excluded_ceiling",
+			n:    "3",
+			c:    MustAnd(NewGreaterThanOrEqualTo(MustParse("1")), NewLessThan(MustParse("3"))),
+			want: ">=1 <3",
+		},
+		{
+			name: "floor",
+			n:    "2",
+			c:    NewGreaterThan(MustParse("1")),
+			want: ">1 <2 || >2",
+		},
+		{
+			name: "floor_inclusive",
+			n:    "1",
+			c:    NewGreaterThanOrEqualTo(MustParse("1")),
+			want: ">1",
+		},
+		{
+			name: "ceiling",
+			n:    "2",
+			c:    NewLessThan(MustParse("3")),
+			want: "<2 || >2 <3",
+		},
+		{
+			name: "ceiling_inclusive",
+			n:    "3",
+			c:    NewLessThanOrEqualTo(MustParse("3")),
+			want: "<3",
+		},
+		{
+			name: "exact",
+			n:    "2",
+			c:    NewExactConstraint(MustParse("2")),
+			want: "[]",
+		},
+		{
+			name: "exact_different",
+			n:    "2",
+			c:    NewExactConstraint(MustParse("3")),
+			want: "3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			n := NewNotEqual(MustParse(tt.n))
+
+			if got := n.And(tt.c).String(); got != tt.want {
+				t.Errorf("NotEqual(%q).And(%q) = %q, want %q", tt.n, tt.c, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleNot() {
+	vulnerable := comver.MustParseConstraint(">=1.0 <1.4.2 || >=2.0 <2.1.5")
+
+	safe := comver.Not(vulnerable)
+
+	fmt.Println(safe)
+	fmt.Println(safe.Check(comver.MustParse("1.4.2")))
+	fmt.Println(safe.Check(comver.MustParse("2.1.4")))
+
+	// Output:
+	// <1-dev || >=1.4.2-dev <2-dev || >=2.1.5-dev
+	// true
+	// false
+}
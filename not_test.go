@@ -0,0 +1,162 @@
+package comver
+
+import "testing"
+
+func TestNot(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		c    Constrainter
+		want string
+	}{
+		{
+			name: "match_all",
+			c:    NewMatchAll(),
+			want: "[]",
+		},
+		{
+			name: "match_none",
+			c:    Or{},
+			want: "*",
+		},
+		{
+			name: "greater_than_or_equal_to",
+			c:    NewGreaterThanOrEqualTo(MustParse("1")),
+			want: "<1",
+		},
+		{
+			name: "greater_than",
+			c:    NewGreaterThan(MustParse("1")),
+			want: "<=1",
+		},
+		{
+			name: "less_than",
+			c:    NewLessThan(MustParse("1")),
+			want: ">=1",
+		},
+		{
+			name: "less_than_or_equal_to",
+			c:    NewLessThanOrEqualTo(MustParse("1")),
+			want: ">1",
+		},
+		{
+			name: "exact",
+			c:    NewExactConstraint(MustParse("1")),
+			want: "!=1",
+		},
+		{
+			name: "interval",
+			c:    MustAnd(NewGreaterThanOrEqualTo(MustParse("1")), NewLessThan(MustParse("2"))),
+			want: "<1 || >=2",
+		},
+		{
+			name: "interval_inclusive",
+			c:    MustAnd(NewGreaterThan(MustParse("1")), NewLessThanOrEqualTo(MustParse("2"))),
+			want: "<=1 || >2",
+		},
+		{
+			name: "or",
+			c:    MustParseConstraint(">=1.0 <1.4.2 || >=2.0 <2.1.5"),
+			want: "<1-dev || >=1.4.2-dev <2-dev || >=2.1.5-dev",
+		},
+		{
+			name: "or_half_lines",
+			c:    MustParseConstraint("<1.0 || >=2.0"),
+			want: ">=1-dev <2-dev",
+		},
+		{
+			name: "or_touching",
+			c:    MustParseConstraint("<=1.0 || >1.0 <2.0"),
+			want: ">=2-dev",
+		},
+		{
+			name: "not_equal",
+			c:    NewNotEqual(MustParse("1.5")),
+			want: "1.5",
+		},
+		{
+			name: "parsed_not_equal",
+			c:    MustParseConstraint("!=1.5"),
+			want: "1.5",
+		},
+		{
+			name: "branch",
+			c:    MustParseConstraint("dev-master"),
+			want: "!=dev-master",
+		},
+		{
+			name: "branches",
+			c:    MustParseConstraint("dev-master || dev-foo"),
+			want: "!=dev-foo !=dev-master",
+		},
+		{
+			name: "branch_or_range",
+			c:    MustParseConstraint("dev-master || ^1.0"),
+			want: "<1-dev || >=2-dev",
+		},
+		{
+			name: "branch_not_equal",
+			c:    NewNotEqual(MustParse("dev-master")),
+			want: "dev-master",
+		},
+		{
+			name: "branch_not_equals",
+			c:    AllOf{NewNotEqual(MustParse("dev-master")), NewNotEqual(MustParse("dev-foo"))},
+			want: "dev-foo || dev-master",
+		},
+		{
+			name: "all_of",
+			c:    AllOf{MustParseConstraint("^1.0 || ^2.0"), MustParseConstraint(">=1.5")},
+			want: "<1.5-dev || >=3-dev",
+		},
+		{
+			name: "flagged",
+			c:    MustParseConstraint("^1.0@beta"),
+			want: "<1-dev || >=2-dev@beta",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Not(tt.c).String(); got != tt.want {
+				t.Errorf("Not(%q) = %q, want %q", tt.c, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNot_Check(t *testing.T) {
+	t.Parallel()
+
+	cs := []string{
+		">=1.0 <1.4.2 || >=2.0 <2.1.5",
+		"^1.0 || ^3.0",
+		"~1.2.3",
+		"1.2.*",
+		"1.0 - 2.0",
+		">1.0 <=2.0",
+		"!=1.5",
+		"<1.0 || 1.5 || >2.0",
+	}
+	vs := []string{
+		"0.9", "1.0.0-dev", "1.0.0-beta", "1.0", "1.2.3", "1.4.1", "1.4.2-dev", "1.4.2",
+		"1.5", "2.0", "2.0.1", "2.1.5", "2.9", "3.0.0-RC1", "3.0", "4.0",
+	}
+
+	for _, c := range cs {
+		for _, v := range vs {
+			t.Run(c+"/"+v, func(t *testing.T) {
+				t.Parallel()
+
+				pc := MustParseConstraint(c)
+				pv := MustParse(v)
+
+				if got, want := Not(pc).Check(pv), !pc.Check(pv); got != want {
+					t.Errorf("Not(%q).Check(%q) = %v, want %v", c, v, got, want)
+				}
+			})
+		}
+	}
+}
@@ -51,3 +51,21 @@ func (o op) floorBounded() bool {
 func (o op) inclusive() bool {
 	return o == lessThanOrEqualTo || o == greaterThanOrEqualTo
 }
+
+// negate returns the op satisfied by exactly the versions not satisfying o
+// with the same version, e.g.: '<' for '>='.
+func (o op) negate() op {
+	switch o {
+	case greaterThanOrEqualTo:
+		return lessThan
+	case greaterThan:
+		return lessThanOrEqualTo
+	case lessThan:
+		return greaterThanOrEqualTo
+	case lessThanOrEqualTo:
+		return greaterThan
+	default:
+		// logic error! This should never happen
+		panic(errUnexpectedOp)
+	}
+}
@@ -1,14 +1,17 @@
 package comver
 
-import "strings"
-
-//nolint:godox
-// TODO: Make Or to be []Constrainter so that we can nest Or.
+import (
+	"iter"
+	"strings"
+)
 
 // Or represents a logical OR operation between multiple
 // [CeilingFloorConstrainter] instances. The zero value for Or is a [match none]
 // constraint which could never be satisfied.
 //
+// Or could not be nested. Use [AllOf] and [AnyOf] to model nested constraints,
+// and [Normalize] to convert them back into an Or.
+//
 // [match none]: https://github.com/composer/semver/blob/main/src/Constraint/MatchNoneConstraint.php
 type Or []CeilingFloorConstrainter
 
@@ -31,3 +34,16 @@ func (o Or) String() string {
 
 	return strings.Join(ss, " || ")
 }
+
+// Intervals returns an iterator over the [CeilingFloorConstrainter] instances
+// of the constraint, in order. Use [CeilingFloorConstrainter.Lower] and
+// [CeilingFloorConstrainter.Upper] to inspect their bounds.
+func (o Or) Intervals() iter.Seq[CeilingFloorConstrainter] {
+	return func(yield func(CeilingFloorConstrainter) bool) {
+		for _, c := range o {
+			if !yield(c) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package comver
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+const (
+	errEmptyConstraintString   stringError = "constraint string is empty"
+	errInvalidConstraintString stringError = "invalid constraint string"
+)
+
+var (
+	orSeparatorRegexp     = regexp.MustCompile(`\s*\|\|?\s*`)
+	commaSeparatorRegexp  = regexp.MustCompile(`\s*,\s*`)
+	opWhitespaceRegexp    = regexp.MustCompile(`(<>|!=|>=?|<=?|==?)\s+`)
+	notEqualRegexp        = regexp.MustCompile(`^(?:<>|!=)(.*)$`)
+	basicComparatorRegexp = regexp.MustCompile(`^(>=?|<=?|==?)?(.*)$`)
+	matchAllRegexp        = regexp.MustCompile(`^v?[xX*](?:\.[xX*])*$`)
+	branchLikeRegexp      = regexp.MustCompile(`^[0-9a-zA-Z-./]+$`)
+	wildcardRegexp        = regexp.MustCompile(`^(v?\d+(?:\.\d+)?(?:\.\d+)?)(?:\.[xX*])+$`)
+)
+
+// ParseConstraint parses a given constraint string into a [Constrainter] or
+// return an error if unable to parse the constraint string.
+//
+// Besides comparison operators, e.g.: '>=1.2' and '!=1.2', it supports:
+//   - tilde version ranges, e.g.: '~1.2', see [NewTilde]
+//   - caret version ranges, e.g.: '^1.2', see [NewCaret]
+//   - wildcard version ranges, e.g.: '1.2.*', '1.x' and '*', see [NewWildcard]
+//   - hyphenated version ranges, e.g.: '1.0 - 2.0', see [NewHyphenRange]
+//
+// Constraints are separated by comma or space for logical AND, and by '||' or
+// '|' for logical OR, e.g. '>=1.0 <1.1 || >=1.2'. Branches of OR that could
+// never be satisfied are dropped. The result is [Compact]-ed, therefore it may
+// be an [Endless], an [ExactConstraint], an [Interval] or an [Or]; or a
+// [NotEqual] or an [AllOf] of them when no ranges are given, e.g.: '!=1.2'
+// and '!=1.2 !=dev-master'; or [MatchNone] when nothing could satisfy it.
+//
+// Same as composer, stable bounds of '<' and '>=' are lowered to their dev
+// pre-releases, e.g. '>=1.0' means '>=1.0.0.0-dev', unless the stable modifier
+// is explicitly given, e.g. '<1.0-stable' means '<1.0.0.0'. Named dev branches, e.g.:
+// 'dev-master', are never satisfied by ranges. They are satisfied by '*', '!='
+// and exact constraints only, e.g.: '!=1.2' but not '<1.2 || >1.2'.
+//
+// [Stability flags], e.g. '^1.0@beta' and '@dev', are stripped from the
+// constraint string. When any is given, a [FlaggedConstraint] wrapping the
+// [Compact]-ed result is returned.
+//
+// Same as composer, inline aliases, e.g. 'dev-main as 1.0.0', are stripped
+// down to the actual version, i.e. 'dev-main', in each constraint, e.g.:
+// '^2.0 || dev-main as 1.0.0' means '^2.0 || dev-main'. Use [ParseAlias] and
+// [Alias.Satisfies] to check aliases against constraints.
+//
+// Due to implementation complexity, it only supports a subset of
+// [composer constraints]. Refer to the [parse_constraint_test.go] for examples.
+//
+// [composer constraints]: https://getcomposer.org/doc/articles/versions.md#writing-version-constraints
+// [Stability flags]: https://getcomposer.org/doc/04-schema.md#package-links
+// [parse_constraint_test.go]: https://github.com/typisttech/comver/blob/main/parse_constraint_test.go
+func ParseConstraint(c string) (Constrainter, error) { //nolint:ireturn
+	var nilC Constrainter
+
+	original := c
+
+	c = strings.TrimSpace(c)
+	if c == "" {
+		return nilC, &ConstraintParseError{original, errEmptyConstraintString}
+	}
+
+	c, flag, flagged := stripStabilityFlags(c)
+
+	var r set
+
+	for _, s := range orSeparatorRegexp.Split(c, -1) {
+		and, err := parseAndConstraint(s)
+		if errors.Is(err, errImpossibleInterval) {
+			continue
+		}
+
+		if err != nil {
+			return nilC, &ConstraintParseError{original, err}
+		}
+
+		r = r.union(and)
+	}
+
+	if flagged {
+		return FlaggedConstraint{r.constraint(), flag}, nil
+	}
+
+	return r.constraint(), nil
+}
+
+// MustParseConstraint is like [ParseConstraint] but panics if the constraint
+// string cannot be parsed.
+func MustParseConstraint(c string) Constrainter { //nolint:ireturn
+	cs, err := ParseConstraint(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return cs
+}
+
+func parseAndConstraint(s string) (set, error) {
+	if s == "" {
+		return set{}, errInvalidConstraintString //nolint:exhaustruct
+	}
+
+	s = opWhitespaceRegexp.ReplaceAllString(s, "$1")
+	r := fullSet()
+
+	for _, comma := range commaSeparatorRegexp.Split(s, -1) {
+		if comma == "" {
+			return set{}, errInvalidConstraintString //nolint:exhaustruct
+		}
+
+		for _, a := range joinHyphenRanges(joinAliases(strings.Fields(comma))) {
+			a = stripAlias(a)
+
+			if m := notEqualRegexp.FindStringSubmatch(a); m != nil {
+				v, err := Parse(m[1])
+				if err != nil {
+					return set{}, err //nolint:exhaustruct
+				}
+
+				r = r.intersect(setOf(NewNotEqual(v)))
+
+				continue
+			}
+
+			c, err := parseSingleConstraint(a)
+			if err != nil {
+				return set{}, err //nolint:exhaustruct
+			}
+
+			r = r.intersect(setOf(c))
+		}
+	}
+
+	return r, nil
+}
+
+// joinHyphenRanges joins space separated hyphenated version ranges back
+// together, e.g.: ["1.0", "-", "2.0"] becomes ["1.0 - 2.0"].
+func joinHyphenRanges(fs []string) []string {
+	r := make([]string, 0, len(fs))
+
+	for i := 0; i < len(fs); i++ {
+		if fs[i] == "-" && len(r) > 0 && i+1 < len(fs) {
+			r[len(r)-1] += " - " + fs[i+1]
+			i++
+
+			continue
+		}
+
+		r = append(r, fs[i])
+	}
+
+	return r
+}
+
+// joinAliases joins space separated inline aliases back together, e.g.:
+// ["dev-main", "as", "1.0"] becomes ["dev-main as 1.0"].
+func joinAliases(fs []string) []string {
+	r := make([]string, 0, len(fs))
+
+	for i := 0; i < len(fs); i++ {
+		if fs[i] == "as" && len(r) > 0 && i+1 < len(fs) {
+			r[len(r)-1] += " as " + fs[i+1]
+			i++
+
+			continue
+		}
+
+		r = append(r, fs[i])
+	}
+
+	return r
+}
+
+func parseSingleConstraint(s string) (CeilingFloorConstrainter, error) { //nolint:cyclop,funlen,ireturn
+	var nilC CeilingFloorConstrainter
+
+	if f, t, ok := strings.Cut(s, " - "); ok {
+		from, err := parseRangeVersion(f)
+		if err != nil {
+			return nilC, err
+		}
+
+		to, err := parseRangeVersion(t)
+		if err != nil {
+			return nilC, err
+		}
+
+		return NewHyphenRange(from, to)
+	}
+
+	if matchAllRegexp.MatchString(s) {
+		return NewMatchAll(), nil
+	}
+
+	if m := wildcardRegexp.FindStringSubmatch(s); m != nil {
+		v, err := Parse(m[1])
+		if err != nil {
+			return nilC, err
+		}
+
+		return NewWildcard(v)
+	}
+
+	if t, ok := strings.CutPrefix(s, "~"); ok {
+		v, err := parseRangeVersion(t)
+		if err != nil {
+			return nilC, err
+		}
+
+		return NewTilde(v)
+	}
+
+	if c, ok := strings.CutPrefix(s, "^"); ok {
+		v, err := parseRangeVersion(c)
+		if err != nil {
+			return nilC, err
+		}
+
+		return NewCaret(v)
+	}
+
+	m := basicComparatorRegexp.FindStringSubmatch(s)
+	if m == nil {
+		return nilC, errInvalidConstraintString
+	}
+
+	v, err := Parse(m[2])
+	if err != nil && strings.HasSuffix(m[2], "-dev") && branchLikeRegexp.MatchString(m[2]) {
+		// recover from an invalid constraint like foobar-dev which should be dev-foobar
+		v, err = Parse("dev-" + strings.TrimSuffix(m[2], "-dev"))
+	}
+
+	if err != nil {
+		return nilC, err
+	}
+
+	lowered := v.lowestPreRelease()
+	if hasStableModifier(m[2]) {
+		// same as composer, explicit stable bounds are not lowered, e.g.: '<2-stable'
+		lowered = v
+	}
+
+	switch m[1] {
+	case "<":
+		return NewLessThan(lowered), nil
+	case "<=":
+		return NewLessThanOrEqualTo(v), nil
+	case ">":
+		return NewGreaterThan(v), nil
+	case ">=":
+		return NewGreaterThanOrEqualTo(lowered), nil
+	default: // "", "=", "=="
+		return NewExactConstraint(v), nil
+	}
+}
+
+// hasStableModifier reports whether the version string ends with an explicit
+// stable modifier, e.g.: '2.0-stable'.
+func hasStableModifier(s string) bool {
+	s, _, _ = strings.Cut(s, "+")
+
+	return strings.HasSuffix(strings.ToLower(strings.TrimSpace(s)), "stable")
+}
+
+// parseRangeVersion is like [Parse] but rejects named dev branches which have no
+// ranges.
+func parseRangeVersion(s string) (Version, error) {
+	v, err := Parse(s)
+	if err != nil {
+		return Version{}, err
+	}
+
+	if v.isBranch() {
+		return Version{}, errInvalidConstraintString
+	}
+
+	return v, nil
+}
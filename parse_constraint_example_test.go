@@ -0,0 +1,47 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleParseConstraint() {
+	c, _ := comver.ParseConstraint(">=1.2 <2.0 || 3.0")
+
+	fmt.Println(c)
+	// Output: >=1.2-dev <2-dev || 3
+}
+
+func ExampleParseConstraint_check() {
+	c, _ := comver.ParseConstraint(">=1.2, <2.0")
+
+	fmt.Println(c.Check(comver.MustParse("1.2.0-beta")))
+	fmt.Println(c.Check(comver.MustParse("2.0.0-RC1")))
+
+	// Output:
+	// true
+	// false
+}
+
+func ExampleParseConstraint_error() {
+	_, err := comver.ParseConstraint(">=1.2 ||")
+
+	fmt.Println(err)
+	// Output: error parsing constraint string ">=1.2 ||"
+}
+
+func ExampleParseConstraint_stabilityFlag() {
+	c, _ := comver.ParseConstraint("^1.2@beta")
+
+	f, _ := c.(comver.FlaggedConstraint)
+
+	fmt.Println(f.Constraint())
+	fmt.Println(f.Stability())
+	fmt.Println(f.Stability().Allows(comver.MustParse("1.3.0-beta1")))
+
+	// Output:
+	// >=1.2-dev <2-dev
+	// beta
+	// true
+}
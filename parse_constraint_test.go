@@ -0,0 +1,423 @@
+package comver
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func goodConstraintTestCases() []struct {
+	name string
+	c    string
+	want string
+} {
+	return []struct {
+		name string
+		c    string
+		want string
+	}{
+		// taken from composer/semver VersionParserTest::simpleConstraints()
+		// https://github.com/composer/semver/blob/1d09200268e7d1052ded8e5da9c73c96a63d18f5/tests/VersionParserTest.php
+		{"greater than", ">1.0.0", ">1"},
+		{"lesser than", "<1.2.3.4", "<1.2.3.4-dev"},
+		{"less/eq than", "<=1.2.3", "<=1.2.3"},
+		{"great/eq than", ">=1.2.3", ">=1.2.3-dev"},
+		{"equals", "=1.2.3", "1.2.3"},
+		{"double equals", "==1.2.3", "1.2.3"},
+		{"no op means eq", "1.2.3", "1.2.3"},
+		{"completes version", "=1.0", "1"},
+		{"shorthand beta", "1.2.3b5", "1.2.3-beta5"},
+		{"shorthand alpha", "1.2.3a1", "1.2.3-alpha1"},
+		{"shorthand patch", "1.2.3p1234", "1.2.3-patch1234"},
+		{"shorthand patch/2", "1.2.3pl1234", "1.2.3-patch1234"},
+		{"accepts spaces", ">= 1.2.3", ">=1.2.3-dev"},
+		{"accepts spaces/2", "< 1.2.3", "<1.2.3-dev"},
+		{"accepts spaces/3", "> 1.2.3", ">1.2.3"},
+		{"keeps modifier", ">=1.0.0-beta", ">=1-beta"},
+		{"lesser than with modifier", "<1.2.3-rc1", "<1.2.3-RC1"},
+		{"great/eq than with modifier", ">=1.2.3-alpha", ">=1.2.3-alpha"},
+		{"lesser than with stable modifier", "<1.2.3-stable", "<1.2.3"},
+		{"great/eq than with stable modifier", ">=1.2.3-STABLE", ">=1.2.3"},
+		{"leading v", ">v1.2.3", ">1.2.3"},
+		{"surrounding spaces", "  >1.2.3  ", ">1.2.3"},
+
+		// taken from composer/semver VersionParserTest::multiConstraintProvider()
+		// https://github.com/composer/semver/blob/1d09200268e7d1052ded8e5da9c73c96a63d18f5/tests/VersionParserTest.php
+		{"and/comma", ">2.0,<=3.0", ">2 <=3"},
+		{"and/space", ">2.0 <=3.0", ">2 <=3"},
+		{"and/spaces", ">2.0  <=3.0", ">2 <=3"},
+		{"and/comma space", ">2.0, <=3.0", ">2 <=3"},
+		{"and/space comma", ">2.0 ,<=3.0", ">2 <=3"},
+		{"and/space comma space", ">2.0 , <=3.0", ">2 <=3"},
+		{"and/spaces comma space", ">2.0   , <=3.0", ">2 <=3"},
+		{"and/spaces after ops", "> 2.0   <=  3.0", ">2 <=3"},
+		{"and/spaces after ops and comma", "> 2.0  ,  <=  3.0", ">2 <=3"},
+		{"and/surrounding spaces", "  > 2.0  ,  <=  3.0 ", ">2 <=3"},
+		{"and/exact", ">=1-beta <=1-beta", "1-beta"},
+		{"and/lowered floor", ">=1 <=1", ">=1-dev <=1"},
+		{"and/exact/2", ">=1 <2 1.5", "1.5"},
+		{"and/redundant", ">1 >2 <4 <3", ">2 <3-dev"},
+		{"or", ">2.0 <=3.0 || <1.0", "<1-dev || >2 <=3"},
+		{"or/single pipe", ">2.0 <=3.0 | <1.0", "<1-dev || >2 <=3"},
+		{"or/no spaces", ">2.0,<=3.0||<1.0", "<1-dev || >2 <=3"},
+		{"or/exact", "1.0 || 2.0", "1 || 2"},
+		{"or/compacted", ">=1 <3 || >=2 <4", ">=1-dev <4-dev"},
+		{"or/match all", "<2 || >=1", ">=0-dev"},
+		{"or/impossible branch", ">2 <1 || 3", "3"},
+		{"or/all impossible branches", ">2 <1 || >4 <3", "[]"},
+
+		// tilde
+		{"tilde", "~1.2", ">=1.2-dev <2-dev"},
+		{"tilde/patch", "~1.2.3", ">=1.2.3-dev <1.3-dev"},
+		{"tilde/leading v", "~v1", ">=1-dev <2-dev"},
+		{"tilde/and", "~1.2 >=1.5", ">=1.5-dev <2-dev"},
+		{"tilde/or", "~1.2.3 || ~2.0", ">=1.2.3-dev <1.3-dev || >=2-dev <3-dev"},
+
+		// caret
+		{"caret", "^1.2", ">=1.2-dev <2-dev"},
+		{"caret/zero minor", "^0.3", ">=0.3-dev <0.4-dev"},
+		{"caret/zero patch", "^0.0.3", ">=0.0.3-dev <0.0.4-dev"},
+		{"caret/pre-release", "^1.2.3-beta.2", ">=1.2.3-beta2 <2-dev"},
+		{"caret/or", "^7.4 || ^8.0", ">=7.4-dev <9-dev"},
+		{"caret/and", "^1.2, >=1.5 || ^3", ">=1.5-dev <2-dev || >=3-dev <4-dev"},
+
+		// taken from composer/semver VersionParserTest::wildcardConstraints()
+		// https://github.com/composer/semver/blob/1d09200268e7d1052ded8e5da9c73c96a63d18f5/tests/VersionParserTest.php
+		{"wildcard", "v2.*", ">=2-dev <3-dev"},
+		{"wildcard/2", "2.*.*", ">=2-dev <3-dev"},
+		{"wildcard/3", "20.*", ">=20-dev <21-dev"},
+		{"wildcard/4", "20.*.*", ">=20-dev <21-dev"},
+		{"wildcard/5", "2.0.*", ">=2-dev <2.1-dev"},
+		{"wildcard/6", "2.x", ">=2-dev <3-dev"},
+		{"wildcard/7", "2.x.x", ">=2-dev <3-dev"},
+		{"wildcard/8", "2.2.x", ">=2.2-dev <2.3-dev"},
+		{"wildcard/9", "2.10.X", ">=2.10-dev <2.11-dev"},
+		{"wildcard/10", "2.1.3.*", ">=2.1.3-dev <2.1.4-dev"},
+		{"wildcard/11", "0.*", "<1-dev"},
+		{"wildcard/12", "0.*.*", "<1-dev"},
+		{"wildcard/13", "0.x", "<1-dev"},
+		{"wildcard/14", "0.x.x", "<1-dev"},
+		{"match all", "*", "*"},
+		{"match all/2", "*.*", "*"},
+		{"match all/3", "v*", "*"},
+		{"match all/4", "x.x", "*"},
+		{"match all/5", "x.X.x.*", "*"},
+		{"hyphen", "1.0 - 2.0", ">=1-dev <2.1-dev"},
+		{"hyphen/full", "1.2.3 - 2.3.4", ">=1.2.3-dev <=2.3.4"},
+		{"hyphen/spaces", "1.0   -   2.0", ">=1-dev <2.1-dev"},
+		{"hyphen/and", "1.0 - 2.0 >=1.5", ">=1.5-dev <2.1-dev"},
+		{"hyphen/and comma", ">=1.5, 1.0 - 2.0", ">=1.5-dev <2.1-dev"},
+		{"hyphen/or", "1.0 - 2.0 || 3.0 - 4.0", ">=1-dev <2.1-dev || >=3-dev <4.1-dev"},
+		{"hyphen/impossible", "2.0 - 1.0 || 3", "3"},
+		{"not equal", "!=1.0.0", "!=1"},
+		{"not equal/angle brackets", "<>1.0.0", "!=1"},
+		{"not equal/spaces", "!= 1.0.0", "!=1"},
+		{"not equal/multiple", "!=1.7 !=1.5", "!=1.5 !=1.7"},
+		{"not equal/or ranges", "!=1.5 || <1", "!=1.5"},
+		{"not equal/and", ">=1 <2 !=1.5", ">=1-dev <1.5 || >1.5 <2-dev"},
+		{"not equal/and multiple", "^1.0 !=1.5 !=1.7", ">=1-dev <1.5 || >1.5 <1.7 || >1.7 <2-dev"},
+		{"not equal/and outside", "^1.0 !=2.5", ">=1-dev <2-dev"},
+		{"not equal/and floor", ">=1.5-beta !=1.5-beta", ">1.5-beta"},
+		{"not equal/and exact", "1.5, !=1.5 || 2", "2"},
+		{"not equal/or", "!=1.5 || 1.5", "*"},
+		{"not equal/or compacted", "!=1.5 || >=1 <2", "*"},
+		{"dev", "1.0.0-dev", "1-dev"},
+		{"dev/great/eq than", ">=1.0-dev", ">=1-dev"},
+		{"dev/lesser than", "<1.0-RC1-dev", "<1-RC1-dev"},
+		{"dev/tilde", "~1.2.2-dev", ">=1.2.2-dev <1.3-dev"},
+		{"dev/caret", "^0.0.3-dev", ">=0.0.3-dev <0.0.4-dev"},
+		{"dev/hyphen", "1.2-beta - 2.3-dev", ">=1.2-beta <=2.3-dev"},
+		{"numeric branch", "1.x-dev", "1.9999999.9999999.9999999-dev"},
+		{"numeric branch/tilde", "~1.x-dev", ">=1.9999999.9999999.9999999-dev <2-dev"},
+		{"branch", "dev-master", "dev-master"},
+		{"branch/eq", "==dev-master", "dev-master"},
+		{"branch/w/o dev", "master", "dev-master"},
+		{"branch/recovered", "foobar-dev", "dev-foobar"},
+		{"branch/recovered/2", "feature/foo.bar-dev", "dev-feature/foo.bar"},
+		{"branch/or", "dev-master || ^1.0", ">=1-dev <2-dev || dev-master"},
+		{"branch/or/2", "^1.0 || dev-master || dev-foo", ">=1-dev <2-dev || dev-foo || dev-master"},
+		{"branch/or match all", "dev-master || *", "*"},
+		{"branch/not equal", "dev-master, !=dev-master || 1", "1"},
+		{"branch/not equal/2", "dev-foo, !=dev-master", "dev-foo"},
+		{"branch/not equal/3", "^1.0 !=dev-master", ">=1-dev <2-dev"},
+		{"branch/not equal/4", "!=dev-master", "!=dev-master"},
+		{"branch/not equal/5", "* !=dev-master", "!=dev-master"},
+		{"branch/not equal/6", "!=dev-master !=1.5 !=dev-foo", "!=1.5 !=dev-foo !=dev-master"},
+		{"branch/not equal/7", "!=dev-master || dev-master", "*"},
+		{"branch/not equal/8", "!=dev-master || !=dev-foo", "*"},
+		{"wildcard/or", "1.* || 3.0.*", ">=1-dev <2-dev || >=3-dev <3.1-dev"},
+		{"wildcard/and", "1.* >=1.5", ">=1.5-dev <2-dev"},
+		{"match all/or", "1.* || *", "*"},
+		{"match all/and", "* <2", "<2-dev"},
+		{"stability flag", "^1.0@beta", ">=1-dev <2-dev@beta"},
+		{"stability flag/upper case", "1.0@RC", "1@RC"},
+		{"stability flag/stable", ">=1.0@stable", ">=1-dev@stable"},
+		{"stability flag/alone", "@dev", "*@dev"},
+		{"stability flag/and", ">=1.0@dev <2.0", ">=1-dev <2-dev@dev"},
+		{"stability flag/and/2", ">=1.0@dev,<2.0@alpha", ">=1-dev <2-dev@dev"},
+		{"stability flag/or", "^1.0@beta || ^2.0@alpha", ">=1-dev <3-dev@alpha"},
+		{"stability flag/branch", "dev-master@dev", "dev-master@dev"},
+		{"stability flag/hyphen", "1.0 - 2.0@beta", ">=1-dev <2.1-dev@beta"},
+		{"alias", "dev-main as 1.0.0", "dev-main"},
+		{"alias/numeric branch", "1.x-dev as 1.0.0", "1.9999999.9999999.9999999-dev"},
+		{"alias/spaces", "  dev-main   as   1.0.x-dev  ", "dev-main"},
+		{"alias/stability flag", "dev-main@dev as 1.0.0", "dev-main@dev"},
+		{"alias/not validated", "dev-load-varnish-only-when-used as ^2.0", "dev-load-varnish-only-when-used"},
+		{"alias/with or", "dev-main as 1.0.0 || ^2.0", ">=2-dev <3-dev || dev-main"},
+		{"alias/inside or", ">=1.0 <2.0 || dev-master as 1.0", ">=1-dev <2-dev || dev-master"},
+		{"alias/with and", "dev-main as 1.0.0, dev-main", "dev-main"},
+	}
+}
+
+func TestParseConstraint(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range goodConstraintTestCases() {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseConstraint(tt.c)
+			if err != nil {
+				t.Fatalf("ParseConstraint() error = %v, wantErr %v", err, nil)
+			}
+
+			if gotString := got.String(); gotString != tt.want {
+				t.Errorf("ParseConstraint().String() got = %q, want %q", gotString, tt.want)
+			}
+		})
+	}
+}
+
+func TestMustParseConstraint(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range goodConstraintTestCases() {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := MustParseConstraint(tt.c)
+
+			if gotString := got.String(); gotString != tt.want {
+				t.Errorf("MustParseConstraint().String() got = %q, want %q", gotString, tt.want)
+			}
+		})
+	}
+}
+
+func badConstraintTestCases() []struct {
+	name    string
+	c       string
+	wantErr error
+} {
+	return []struct {
+		name    string
+		c       string
+		wantErr error
+	}{
+		{"empty", "", errEmptyConstraintString},
+		{"spaces only", "   ", errEmptyConstraintString},
+		{"invalid version", "1.0.0-meh", errInvalidVersionString},
+		{"invalid version/2", ">=foo", errInvalidVersionString},
+		{"just an operator", ">=", errEmptyString},
+		{"double comma", ">2.0,,<=3.0", errInvalidConstraintString},
+		{"trailing comma", ">2.0,", errInvalidConstraintString},
+		{"triple pipes", ">2.0 ||| <=3.0", errInvalidConstraintString},
+		{"empty or", ">2.0 || || <=3.0", errInvalidConstraintString},
+		{"leading or", "|| <=3.0", errInvalidConstraintString},
+		{"trailing or", ">2.0 ||", errInvalidConstraintString},
+		{"space in version", "1.0 .2", errInvalidVersionString},
+		{"just a tilde", "~", errEmptyString},
+		{"just a tilde/2", "~1 ~", errEmptyString},
+		{"tilde with greater than", "~>1.2", errInvalidVersionString},
+		{"just a caret", "^", errEmptyString},
+		{"just a caret/2", "^8 || ^", errEmptyString},
+		{"hyphen without spaces", "1.0-2.0", errInvalidVersionString},
+		{"hyphen without from", "- 2.0", errInvalidVersionString},
+		{"hyphen without to", "1.0 -", errInvalidVersionString},
+		{"hyphen across comma", "1.0, - 2.0", errInvalidVersionString},
+		{"chained hyphens", "1.0 - 2.0 - 3.0", errInvalidVersionString},
+		{"just a not equal", "!=", errEmptyString},
+		{"not equal with invalid version", "!=foo", errInvalidVersionString},
+		{"branch/tilde", "~dev-master", errInvalidConstraintString},
+		{"branch/caret", "^dev-master", errInvalidConstraintString},
+		{"branch/hyphen", "1.0 - dev-master", errInvalidConstraintString},
+		{"branch/not recoverable", "foo@bar-dev", errNotFixedVersion},
+		{"wildcard in the middle", "1.*.2", errInvalidVersionString},
+		{"wildcard with modifier", "1.*-beta", errInvalidVersionString},
+		{"stability flag/unknown", "^1.0@foo", errInvalidVersionString},
+		{"alias/invalid actual", "foo as 1.0.0", errInvalidVersionString},
+		{"alias/without aliased", "dev-main as", errInvalidVersionString},
+		{"stability flag/double", "^1.0@dev@beta", errNotFixedVersion},
+		{"stability flag/with modifier", "^1.0@beta2", errInvalidVersionString},
+	}
+}
+
+func TestParseConstraint_ConstraintParseError(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range badConstraintTestCases() {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseConstraint(tt.c)
+			if err == nil {
+				t.Fatalf("ParseConstraint() got = %s error = %v, wantErr %v", got, err, tt.wantErr)
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseConstraint() error = %#v, wantErr %#v", err, tt.wantErr)
+			}
+
+			var wantParseError *ConstraintParseError
+			if !errors.As(err, &wantParseError) {
+				t.Fatalf("ParseConstraint() error = %#v, wantErr %#v", err, wantParseError)
+			}
+
+			if wantParseError.Original() != tt.c {
+				t.Errorf(
+					"ParseConstraint() error.Original() = %v, want %v",
+					wantParseError.Original(),
+					tt.c,
+				)
+			}
+		})
+	}
+}
+
+func TestParseConstraint_Check(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    string
+		v    string
+		want bool
+	}{
+		{">=1.0", "1.0.0", true},
+		{">=1.0", "1.0.0-alpha", true},
+		{">=1.0", "0.9", false},
+		{"<2.0", "2.0.0-RC1", false},
+		{"<2.0", "1.9999", true},
+		{"<2.0-beta", "2.0.0-alpha", true},
+		{"<2.0-beta", "2.0.0-beta", false},
+		{">1.0 <=2.0", "1.0.0.1", true},
+		{">1.0 <=2.0", "2.0.0-patch1", false},
+		{"1.0 || 2.0", "2", true},
+		{"1.0 || 2.0", "1.5", false},
+		{">2 <1 || >4 <3", "2.5", false},
+		{"~1.2", "1.9.9", true},
+		{"~1.2", "2.0.0-alpha", false},
+		{"~1.2.3", "1.2.3-beta", true},
+		{"~1.2.3", "1.3", false},
+		{"^0.3", "0.3.9", true},
+		{"^0.3", "0.4", false},
+		{"^1.2", "1.99", true},
+		{"^1.2", "2.0.0-beta", false},
+		{"1.2.*", "1.2.0-alpha", true},
+		{"1.2.*", "1.2.99", true},
+		{"1.2.*", "1.3.0-alpha", false},
+		{"*", "0.0.0-alpha", true},
+		{"!=1.5", "1.5.0", false},
+		{"!=1.5", "1.5.0-RC1", true},
+		{"^1.0 !=1.5", "1.4", true},
+		{"^1.0 !=1.5", "1.5", false},
+		{"dev-master", "dev-master", true},
+		{"dev-master", "dev-foo", false},
+		{"dev-master || >=1.0", "dev-master", true},
+		{"dev-master || >=1.0", "dev-foo", false},
+		{">=1.0", "dev-master", false},
+		{"dev-foo, !=dev-master", "dev-master", false},
+		{"dev-foo, !=dev-master", "dev-foo", true},
+		{"^1.0 !=dev-master", "1.5", true},
+		{"!=1.5 !=dev-master", "dev-master", false},
+		{"!=1.5 !=dev-master", "dev-foo", true},
+		{"!=1.5", "dev-master", true},
+		{"<1.5 || >1.5", "dev-master", false},
+		{"<2 || >=1", "dev-master", false},
+		{"!=dev-master", "dev-master", false},
+		{"!=dev-master", "dev-foo", true},
+		{"!=dev-master", "1.5", true},
+		{"!=1.5 || ^1", "dev-master", true},
+		{">dev-master", "dev-master", false},
+		{">dev-master", "dev-foo", false},
+		{"^1.0", "1.x-dev", true},
+		{"^1.0", "1.0.0-RC1-dev", true},
+		{"1.0 - 2.0", "2.0.9", true},
+		{"1.0 - 2.0", "2.1.0-alpha", false},
+		{"1.0 - 2.0.0", "2.0.0", true},
+		{"1.0 - 2.0.0", "2.0.0.1", false},
+		{"^1.0@dev", "1.0.0-dev", true},
+		{"^1.0@dev", "2.0.0-dev", false},
+		{"@dev", "dev-master", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.c+"/"+tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			c := MustParseConstraint(tt.c)
+
+			if got := c.Check(MustParse(tt.v)); got != tt.want {
+				t.Errorf("%q.Check(%q) = %v, want %v", tt.c, tt.v, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseConstraint_FlaggedConstraint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c              string
+		want           Stability
+		wantConstraint string
+	}{
+		{"^1.0@beta", StabilityBeta, ">=1-dev <2-dev"},
+		{"1.0@RC", StabilityRC, "1"},
+		{">=1.0@stable", StabilityStable, ">=1-dev"},
+		{"@dev", StabilityDev, "*"},
+		{">=1.0@alpha,<2.0@beta", StabilityAlpha, ">=1-dev <2-dev"},
+		{"^1.0@stable || ^2.0@dev", StabilityDev, ">=1-dev <3-dev"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.c, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := ParseConstraint(tt.c)
+			if err != nil {
+				t.Fatalf("ParseConstraint(%q) error = %v", tt.c, err)
+			}
+
+			f, ok := c.(FlaggedConstraint)
+			if !ok {
+				t.Fatalf("ParseConstraint(%q) = %T, want FlaggedConstraint", tt.c, c)
+			}
+
+			if got := f.Stability(); got != tt.want {
+				t.Errorf("ParseConstraint(%q).Stability() = %v, want %v", tt.c, got, tt.want)
+			}
+
+			if got := f.Constraint().String(); got != tt.wantConstraint {
+				t.Errorf("ParseConstraint(%q).Constraint() = %q, want %q", tt.c, got, tt.wantConstraint)
+			}
+		})
+	}
+}
+
+func TestParseConstraint_notFlagged(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range goodConstraintTestCases() {
+		if strings.Contains(tt.c, "@") {
+			continue
+		}
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := MustParseConstraint(tt.c)
+
+			if _, ok := c.(FlaggedConstraint); ok {
+				t.Errorf("ParseConstraint(%q) = %T, want not FlaggedConstraint", tt.c, c)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package comver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Pretty returns the shortest idiomatic composer constraint string of the
+// given [Constrainter], e.g.: '^1.2' instead of '>=1.2-dev <2-dev' and
+// '~1.2.3' instead of '>=1.2.3-dev <1.3-dev'.
+//
+// The [Canonical] form of the constraint is rendered. Intervals are matched
+// against caret, tilde and wildcard version ranges, and the shortest one
+// wins (caret first, then tilde, then wildcard on ties). Otherwise, it falls
+// back to explicit operators, without the dev pre-releases lowered by
+// [ParseConstraint], e.g.: '>=1.2 <2.1' instead of '>=1.2-dev <2.1-dev'.
+// Stable bounds which are not lowered are rendered with explicit stable
+// modifiers, e.g.: '>=1 <1.5-stable || >1.5 <2' for '^1.0 !=1.5'.
+//
+// Ceilings with invalid majors are rounded up to the next valid major, e.g.:
+// '<1000000000' for '<100000000-dev', and ceilings beyond the largest valid
+// major, e.g.: '<1000000000000', are omitted because no versions could reach
+// them.
+//
+// The result is parsable by [ParseConstraint] into a logically equivalent
+// constraint, except for the ceilings mentioned above and the constraints that
+// could never be satisfied, which are rendered as '[]', same as [MatchNone].
+func Pretty(c Constrainter) string {
+	switch c := Canonical(c).(type) {
+	case FlaggedConstraint:
+		return Pretty(c.constraint) + "@" + c.stability.String()
+	case Or:
+		ss := make([]string, len(c))
+		for i := range c {
+			ss[i] = prettyCeilingFloor(c[i])
+		}
+
+		return strings.Join(ss, " || ")
+	case CeilingFloorConstrainter:
+		return prettyCeilingFloor(c)
+	default:
+		return c.String()
+	}
+}
+
+func prettyCeilingFloor(c CeilingFloorConstrainter) string {
+	if _, ok := c.(ExactConstraint); ok || matchAll(c) || branchBounded(c) {
+		return c.String()
+	}
+
+	floor, ceiling := c.floor(), c.ceiling()
+
+	if !floor.matchAll() && floor.op == greaterThanOrEqualTo &&
+		!ceiling.matchAll() && ceiling.op == lessThan {
+		if s, ok := prettyRange(c); ok {
+			return s
+		}
+	}
+
+	if !ceiling.matchAll() && !validMajor(ceiling.version.major) {
+		ceiling = reachableCeiling(ceiling)
+		if floor.matchAll() && ceiling.matchAll() {
+			return prettyEndless(numericMatchAll())
+		}
+	}
+
+	ss := make([]string, 0, 2) //nolint:mnd
+
+	if !floor.matchAll() {
+		ss = append(ss, prettyEndless(floor))
+	}
+
+	if !ceiling.matchAll() {
+		ss = append(ss, prettyEndless(ceiling))
+	}
+
+	return strings.Join(ss, " ")
+}
+
+// prettyRange returns the shortest caret, tilde or wildcard version range
+// equivalent to the [CeilingFloorConstrainter].
+func prettyRange(c CeilingFloorConstrainter) (string, bool) {
+	var best string
+
+	for _, base := range rangeBases(*c.floor().version) {
+		candidates := []string{"^" + base, "~" + base}
+		if isNumeric(base) {
+			candidates = append(candidates, base+".*")
+		}
+
+		for _, s := range candidates {
+			r, err := parseSingleConstraint(s)
+			if err != nil || compare(r, c) != 0 {
+				continue
+			}
+
+			if best == "" || len(s) < len(best) {
+				best = s
+			}
+		}
+	}
+
+	return best, best != ""
+}
+
+// rangeBases returns the version strings of all precisions which could be the
+// base of version ranges having the given version as the floor, e.g.: '1.2',
+// '1.2.0' and '1.2.0.0' for '1.2.0.0-dev'.
+func rangeBases(v Version) []string {
+	cs := []uint64{v.major, v.minor, v.patch, v.tweak}
+
+	suffix := v.suffix()
+	if v.modifier == modifierDev && v.preRelease == "" && !v.devSuffix {
+		// lowered by composer, e.g.: '^1.2' means '>=1.2.0.0-dev'
+		suffix = ""
+	}
+
+	bs := make([]string, 0, len(cs))
+
+	for p := len(cs); p >= 1; p-- {
+		ss := make([]string, p)
+		for i := range p {
+			ss[i] = strconv.FormatUint(cs[i], 10)
+		}
+
+		bs = append(bs, strings.Join(ss, ".")+suffix)
+
+		if cs[p-1] != 0 {
+			// less precise bases would lose this component
+			break
+		}
+	}
+
+	return bs
+}
+
+// prettyEndless returns the string of the [Endless] without the dev
+// pre-releases lowered by [ParseConstraint], e.g.: '>=1.2' for '>=1.2-dev',
+// and with explicit stable modifiers on stable bounds which would otherwise be
+// lowered, e.g.: '<1.2-stable' for '<1.2'.
+func prettyEndless(e Endless) string {
+	if e.op != greaterThanOrEqualTo && e.op != lessThan {
+		return e.String()
+	}
+
+	v := *e.version
+
+	switch {
+	case v.modifier == modifierDev && v.preRelease == "" && !v.devSuffix:
+		v.modifier = modifierStable
+
+		return e.op.String() + v.Short()
+	case v.modifier == modifierStable && !v.isBranch():
+		return e.op.String() + v.Short() + "-stable"
+	default:
+		return e.String()
+	}
+}
+
+// reachableCeiling returns the ceiling with an invalid major rounded up to the
+// next valid major, e.g.: '<1000000000-dev' for '<100000000-dev', or a match
+// all when it is beyond the largest valid major because no versions could
+// reach it.
+func reachableCeiling(e Endless) Endless {
+	digits := len(strconv.FormatUint(e.version.major, 10))
+	if digits > 12 { //nolint:mnd
+		return NewMatchAll()
+	}
+
+	major := uint64(1)
+	for range digits {
+		major *= 10
+	}
+
+	return NewLessThan(Version{major: major}.lowestPreRelease())
+}
+
+func isNumeric(s string) bool {
+	return strings.Trim(s, "0123456789.") == ""
+}
@@ -0,0 +1,20 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExamplePretty() {
+	fmt.Println(comver.Pretty(comver.MustParseConstraint(">=1.2 <2")))
+	fmt.Println(comver.Pretty(comver.MustParseConstraint(">=1.2.3 <1.3")))
+	fmt.Println(comver.Pretty(comver.MustParseConstraint(">=1.2 <1.3")))
+	fmt.Println(comver.Pretty(comver.MustParseConstraint(">=1.2 <2.1")))
+
+	// Output:
+	// ^1.2
+	// ~1.2.3
+	// 1.2.*
+	// >=1.2 <2.1
+}
@@ -0,0 +1,125 @@
+package comver
+
+import "testing"
+
+func TestPretty(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c     string
+		want  string
+		lossy bool
+	}{
+		{"^1.2", "^1.2", false},
+		{">=1.2 <2", "^1.2", false},
+		{">=1.2.3 <1.3", "~1.2.3", false},
+		{"~1.2.3", "~1.2.3", false},
+		{"~1.2", "^1.2", false},
+		{"~1", "^1", false},
+		{"~1.2.3.4", "~1.2.3.4", false},
+		{"^0.3", "^0.3", false},
+		{"^0.0.3", "^0.0.3", false},
+		{"^0.0", "<0.1", false},
+		{"1.2.*", "1.2.*", false},
+		{"~1.2.0", "1.2.*", false},
+		{"1.*", "^1", false},
+		{"1.2.3.*", "1.2.3.*", false},
+		{">=1.2-beta <2", "^1.2-beta", false},
+		{"~1.2.3-RC1", "~1.2.3-RC1", false},
+		{">=1.2.3.4 <2.0.0.1", ">=1.2.3.4 <2.0.0.1", false},
+		{"1.0 - 2.0", ">=1 <2.1", false},
+		{"1.0 - 2.0.0", ">=1 <=2", false},
+		{"1 - 99999999", ">=1 <1000000000", true},
+		{"1 - 9999999999", ">=1 <100000000000", true},
+		{"1 - 999999999999", ">=1", true},
+		{"^99999999", "^99999999", false},
+		{"^999999999999", "^999999999999", false},
+		{">=1.2", ">=1.2", false},
+		{">1.2", ">1.2", false},
+		{"<2", "<2", false},
+		{"<=2", "<=2", false},
+		{">=1.2-dev", ">=1.2", false},
+		{">1.2-dev", ">1.2-dev", false},
+		{">=1.2-beta-dev <2", "^1.2-beta-dev", false},
+		{"1.2.3", "1.2.3", false},
+		{"*", "*", false},
+		{"!=1.5", "!=1.5", false},
+		{"^1.0 || ^2.0", ">=1 <3", false},
+		{"^1.0 || ^3.0", "^1 || ^3", false},
+		{"<1.0 || 1.5 || ~2.3.1", "<1 || 1.5 || ~2.3.1", false},
+		{"^1.0 !=1.5", ">=1 <1.5-stable || >1.5 <2", false},
+		{"^2.0 !=2.0", ">=2 <2-stable || >2 <3", false},
+		{"~1.2 !=1.5", ">=1.2 <1.5-stable || >1.5 <2", false},
+		{">1.5 !=2.0", ">1.5 <2-stable || >2", false},
+		{"<2-stable", "<2-stable", false},
+		{">=1-stable <2-stable", ">=1-stable <2-stable", false},
+		{"dev-master || ^1.2", "^1.2 || dev-master", false},
+		{"^1.2@beta", "^1.2@beta", false},
+		{"^1.0 ^2.0", "[]", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.c, func(t *testing.T) {
+			t.Parallel()
+
+			c := MustParseConstraint(tt.c)
+
+			got := Pretty(c)
+			if got != tt.want {
+				t.Errorf("Pretty(%q) = %q, want %q", tt.c, got, tt.want)
+			}
+
+			if tt.lossy {
+				return
+			}
+
+			if p := MustParseConstraint(got); !Equal(p, c) {
+				t.Errorf("ParseConstraint(Pretty(%q)) = %q, want equal to %q", tt.c, p, c)
+			}
+		})
+	}
+}
+
+func TestPretty_nonParsed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		c    Constrainter
+		want string
+	}{
+		{
+			name: "match_none",
+			c:    NewMatchNone(),
+			want: "[]",
+		},
+		{
+			name: "not_equal",
+			c:    NewNotEqual(MustParse("1.5")),
+			want: "!=1.5",
+		},
+		{
+			name: "branch_not_equal",
+			c:    NewNotEqual(MustParse("dev-master")),
+			want: "!=dev-master",
+		},
+		{
+			name: "stable_interval",
+			c:    MustNewInterval(MustParse("1"), MustParse("2"), true, false),
+			want: ">=1-stable <2-stable",
+		},
+		{
+			name: "nested",
+			c:    AllOf{AnyOf{MustParseConstraint("^1.0"), MustParseConstraint("^3.0")}, MustParseConstraint(">=1.5")},
+			want: "^1.5 || ^3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Pretty(tt.c); got != tt.want {
+				t.Errorf("Pretty(%q) = %q, want %q", tt.c, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package comver
+
+import "slices"
+
+// set represents the versions satisfying a [Constrainter], with numbered
+// versions and named dev branches kept apart, same as composer's [Intervals].
+//
+// Named dev branches are not comparable with ranges. Thus, setOf is the only
+// place deciding which named dev branches a [Constrainter] is satisfied by:
+//   - match all is satisfied by all named dev branches
+//   - ranges, e.g.: '>=1.0' and '>dev-master', are satisfied by none of them
+//   - [ExactConstraint] is satisfied by its own named dev branch only
+//   - [NotEqual] is satisfied by all named dev branches except its own
+//
+// [Intervals]: https://github.com/composer/semver/blob/main/src/Intervals.php
+type set struct {
+	// The [Compact]-ed ranges of the numbered versions, never bounded by named
+	// dev branches, where '>=0-dev' means all numbered versions.
+	numeric Or
+	// The sorted named dev branches, either included or excluded.
+	branches []Version
+	// Whether the set contains all named dev branches except the branches.
+	exclude bool
+}
+
+// fullSet returns the set of all versions, i.e.: match all.
+func fullSet() set {
+	return set{
+		numeric:  Or{numericMatchAll()},
+		branches: nil,
+		exclude:  true,
+	}
+}
+
+// numericMatchAll returns the [Endless] satisfied by all numbered versions but
+// no named dev branches, i.e.: '>=0-dev'.
+func numericMatchAll() Endless {
+	return NewGreaterThanOrEqualTo(Version{modifier: modifierDev}) //nolint:exhaustruct
+}
+
+// setOf returns the set of versions satisfying the [Constrainter]. Stability
+// flags are ignored. Unknown [Constrainter] implementations result in the
+// empty set.
+func setOf(c Constrainter) set { //nolint:cyclop
+	switch c := c.(type) {
+	case Or:
+		var s set
+		for i := range c {
+			s = s.union(setOf(c[i]))
+		}
+
+		return s
+	case CeilingFloorConstrainter:
+		if matchAll(c) {
+			return fullSet()
+		}
+
+		if !branchBounded(c) {
+			return set{numeric: compactNumeric(Or{c})} //nolint:exhaustruct
+		}
+
+		if e, ok := c.(ExactConstraint); ok {
+			return set{branches: []Version{e.version}} //nolint:exhaustruct
+		}
+
+		// ranges bounded by named dev branches could never be satisfied
+		return set{} //nolint:exhaustruct
+	case NotEqual:
+		return setOf(NewExactConstraint(c.version)).complement()
+	case FlaggedConstraint:
+		return setOf(c.constraint)
+	case AllOf:
+		s := fullSet()
+		for i := range c {
+			s = s.intersect(setOf(c[i]))
+		}
+
+		return s
+	case AnyOf:
+		var s set
+		for i := range c {
+			s = s.union(setOf(c[i]))
+		}
+
+		return s
+	default:
+		return set{} //nolint:exhaustruct
+	}
+}
+
+func (s set) union(t set) set {
+	r := set{
+		numeric:  compactNumeric(append(slices.Clone(s.numeric), t.numeric...)),
+		branches: nil,
+		exclude:  s.exclude || t.exclude,
+	}
+
+	switch {
+	case s.exclude && t.exclude:
+		r.branches = intersectVersions(s.branches, t.branches)
+	case s.exclude:
+		r.branches = subtractVersions(s.branches, t.branches)
+	case t.exclude:
+		r.branches = subtractVersions(t.branches, s.branches)
+	default:
+		r.branches = unionVersions(s.branches, t.branches)
+	}
+
+	return r
+}
+
+func (s set) intersect(t set) set {
+	r := set{
+		numeric:  intersectNumeric(s.numeric, t.numeric),
+		branches: nil,
+		exclude:  s.exclude && t.exclude,
+	}
+
+	switch {
+	case s.exclude && t.exclude:
+		r.branches = unionVersions(s.branches, t.branches)
+	case s.exclude:
+		r.branches = subtractVersions(t.branches, s.branches)
+	case t.exclude:
+		r.branches = subtractVersions(s.branches, t.branches)
+	default:
+		r.branches = intersectVersions(s.branches, t.branches)
+	}
+
+	return r
+}
+
+func (s set) complement() set {
+	return set{
+		numeric:  complementNumeric(s.numeric),
+		branches: s.branches,
+		exclude:  !s.exclude,
+	}
+}
+
+func (s set) empty() bool {
+	return len(s.numeric) == 0 && len(s.branches) == 0 && !s.exclude
+}
+
+func (s set) full() bool {
+	return len(complementNumeric(s.numeric)) == 0 && len(s.branches) == 0 && s.exclude
+}
+
+// constraint returns the [Compact]-ed [Constrainter] satisfied by the set,
+// i.e.: [MatchNone], a [CeilingFloorConstrainter], an [Or], a match all, a
+// [NotEqual] or an [AllOf] of [NotEqual].
+//
+// Sets containing all named dev branches but not all numbered versions (except
+// a few) could not be expressed by composer, e.g.: the complement of '>=1.0'.
+// For them, the named dev branches are dropped, i.e.: '<1.0'.
+func (s set) constraint() Constrainter { //nolint:ireturn
+	if s.empty() {
+		return NewMatchNone()
+	}
+
+	if !s.exclude {
+		o := slices.Clone(s.numeric)
+		for _, b := range s.branches {
+			o = append(o, NewExactConstraint(b))
+		}
+
+		return Compact(o)
+	}
+
+	holes := complementNumeric(s.numeric)
+	ns := make(AllOf, 0, len(holes)+len(s.branches))
+
+	for _, h := range holes {
+		e, ok := h.(ExactConstraint)
+		if !ok {
+			return Compact(slices.Clone(s.numeric))
+		}
+
+		ns = append(ns, NewNotEqual(e.version))
+	}
+
+	for _, b := range s.branches {
+		ns = append(ns, NewNotEqual(b))
+	}
+
+	switch len(ns) {
+	case 0:
+		return NewMatchAll()
+	case 1:
+		return ns[0]
+	default:
+		return ns
+	}
+}
+
+// compactNumeric returns the [Compact]-ed [Or] of the ranges of numbered
+// versions, with the redundant '>=0-dev' floors trimmed and the ranges
+// satisfied by no numbered versions, i.e.: '<0-dev', dropped.
+func compactNumeric(o Or) Or {
+	r := make(Or, 0, len(o))
+
+	for _, c := range o {
+		floor, ceiling := c.floor(), c.ceiling()
+
+		if !ceiling.matchAll() && ceiling.compare(numericMatchAll().negate()) == 0 {
+			continue
+		}
+
+		if _, ok := c.(Interval); ok && floor.compare(numericMatchAll()) == 0 {
+			c = ceiling
+		}
+
+		r = append(r, c)
+	}
+
+	switch c := Compact(r).(type) {
+	case Or:
+		return c
+	case CeilingFloorConstrainter:
+		return Or{c}
+	default:
+		return Or{}
+	}
+}
+
+func intersectNumeric(a, b Or) Or {
+	r := make(Or, 0, len(a)*len(b))
+
+	for _, x := range a {
+		for _, y := range b {
+			c, err := And(x.floor(), x.ceiling(), y.floor(), y.ceiling())
+			if err != nil {
+				// could never be satisfied
+				continue
+			}
+
+			r = append(r, c)
+		}
+	}
+
+	return compactNumeric(r)
+}
+
+// complementNumeric returns the ranges of numbered versions not satisfying the
+// given ranges, e.g.: '<1.0 || >=2.0' for '>=1.0 <2.0'.
+func complementNumeric(o Or) Or {
+	r := Or{numericMatchAll()}
+
+	for _, c := range o {
+		n := make(Or, 0, 2) //nolint:mnd
+
+		if f := c.floor(); !f.matchAll() {
+			n = append(n, f.negate())
+		}
+
+		if e := c.ceiling(); !e.matchAll() {
+			n = append(n, e.negate())
+		}
+
+		r = intersectNumeric(r, n)
+	}
+
+	return r
+}
+
+func unionVersions(a, b []Version) []Version {
+	r := append(slices.Clone(a), b...)
+	slices.SortFunc(r, Version.Compare)
+
+	return slices.CompactFunc(r, func(v, w Version) bool {
+		return v.Compare(w) == 0
+	})
+}
+
+func intersectVersions(a, b []Version) []Version {
+	return slices.DeleteFunc(slices.Clone(a), func(v Version) bool {
+		return !slices.ContainsFunc(b, func(w Version) bool { return v.Compare(w) == 0 })
+	})
+}
+
+func subtractVersions(a, b []Version) []Version {
+	return slices.DeleteFunc(slices.Clone(a), func(v Version) bool {
+		return slices.ContainsFunc(b, func(w Version) bool { return v.Compare(w) == 0 })
+	})
+}
+
+// flagged wraps the [Constrainter] with the least stable flag nested in any of
+// the given [Constrainter] instances, if any.
+func flagged(r Constrainter, cs ...Constrainter) Constrainter { //nolint:ireturn
+	if flag, ok := leastStableFlag(cs); ok {
+		return FlaggedConstraint{r, flag}
+	}
+
+	return r
+}
+
+func leastStableFlag(cs []Constrainter) (Stability, bool) {
+	var (
+		flag  Stability
+		found bool
+	)
+
+	for _, c := range cs {
+		if f, ok := flagOf(c); ok && (!found || f < flag) {
+			flag, found = f, true
+		}
+	}
+
+	return flag, found
+}
+
+// flagOf returns the least stable flag nested in the [Constrainter].
+func flagOf(c Constrainter) (Stability, bool) {
+	switch c := c.(type) {
+	case FlaggedConstraint:
+		if f, ok := flagOf(c.constraint); ok {
+			return min(f, c.stability), true
+		}
+
+		return c.stability, true
+	case AllOf:
+		return leastStableFlag(c)
+	case AnyOf:
+		return leastStableFlag(c)
+	default:
+		return StabilityStable, false
+	}
+}
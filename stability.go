@@ -0,0 +1,101 @@
+package comver
+
+import (
+	"slices"
+	"strings"
+)
+
+// Stability represents a [composer stability], from the least stable
+// [StabilityDev] to the most stable [StabilityStable].
+// Stabilities are ordered, i.e.: a greater Stability is more stable.
+// The zero value for Stability is [StabilityStable], which is also the
+// default composer minimum-stability.
+//
+// [composer stability]: https://getcomposer.org/doc/04-schema.md#minimum-stability
+type Stability int8
+
+const (
+	StabilityStable     Stability   = 0
+	StabilityRC         Stability   = -10
+	StabilityBeta       Stability   = -20
+	StabilityAlpha      Stability   = -30
+	StabilityDev        Stability   = -40
+	errInvalidStability stringError = "invalid stability string"
+)
+
+// ParseStability parses a given stability string, i.e.: 'stable', 'RC',
+// 'beta', 'alpha' or 'dev' (case-insensitive) into a [Stability] or return an
+// error if unable to parse the stability string.
+func ParseStability(s string) (Stability, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "stable":
+		return StabilityStable, nil
+	case "rc":
+		return StabilityRC, nil
+	case "beta":
+		return StabilityBeta, nil
+	case "alpha":
+		return StabilityAlpha, nil
+	case "dev":
+		return StabilityDev, nil
+	}
+
+	return StabilityStable, errInvalidStability
+}
+
+func (s Stability) String() string {
+	switch s {
+	case StabilityStable:
+		return "stable"
+	case StabilityRC:
+		return "RC"
+	case StabilityBeta:
+		return "beta"
+	case StabilityAlpha:
+		return "alpha"
+	case StabilityDev:
+		return "dev"
+	default:
+		return ""
+	}
+}
+
+// Allows reports whether the version is at least as stable as s, i.e.:
+// whether composer accepts the version when s is the minimum-stability.
+func (s Stability) Allows(v Version) bool {
+	return v.Stability() >= s
+}
+
+// FilterByStability returns the versions which are at least as stable as the
+// given minimum-stability, keeping their order.
+func FilterByStability(vs []Version, minimum Stability) []Version {
+	return slices.DeleteFunc(slices.Clone(vs), func(v Version) bool {
+		return !minimum.Allows(v)
+	})
+}
+
+// Stability returns the [Stability] of the version.
+//
+// Same as composer, patch versions (e.g. 1.0.0-patch1) are stable while dev
+// branches (e.g. dev-master and 1.x-dev) and versions with dev suffixes
+// (e.g. 1.0.0-beta1-dev) are dev.
+func (v Version) Stability() Stability {
+	if v.isBranch() || v.devSuffix {
+		return StabilityDev
+	}
+
+	switch v.modifier {
+	case modifierRC:
+		return StabilityRC
+	case modifierBeta:
+		return StabilityBeta
+	case modifierAlpha:
+		return StabilityAlpha
+	case modifierDev:
+		return StabilityDev
+	case modifierPatch, modifierStable:
+		return StabilityStable
+	default:
+		return StabilityStable
+	}
+}
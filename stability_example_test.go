@@ -0,0 +1,52 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleParseStability() {
+	s, _ := comver.ParseStability("beta")
+
+	fmt.Println(s)
+	fmt.Println(s.Allows(comver.MustParse("1.0.0-RC1")))
+	fmt.Println(s.Allows(comver.MustParse("1.0.0-alpha1")))
+
+	// Output:
+	// beta
+	// true
+	// false
+}
+
+func ExampleVersion_Stability() {
+	fmt.Println(comver.MustParse("1.0.0").Stability())
+	fmt.Println(comver.MustParse("1.0.0-patch1").Stability())
+	fmt.Println(comver.MustParse("1.0.0-RC1").Stability())
+	fmt.Println(comver.MustParse("1.0.0-beta1-dev").Stability())
+	fmt.Println(comver.MustParse("dev-master").Stability())
+
+	// Output:
+	// stable
+	// stable
+	// RC
+	// dev
+	// dev
+}
+
+func ExampleFilterByStability() {
+	vs := []comver.Version{
+		comver.MustParse("dev-master"),
+		comver.MustParse("1.0.0-beta1"),
+		comver.MustParse("1.0.0-RC1"),
+		comver.MustParse("1.0.0"),
+	}
+
+	for _, v := range comver.FilterByStability(vs, comver.StabilityRC) {
+		fmt.Println(v)
+	}
+
+	// Output:
+	// 1.0.0.0-RC1
+	// 1.0.0.0
+}
@@ -0,0 +1,174 @@
+package comver
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestParseStability(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s       string
+		want    Stability
+		wantErr error
+	}{
+		{"stable", StabilityStable, nil},
+		{"RC", StabilityRC, nil},
+		{"rc", StabilityRC, nil},
+		{"beta", StabilityBeta, nil},
+		{"Beta", StabilityBeta, nil},
+		{"alpha", StabilityAlpha, nil},
+		{"dev", StabilityDev, nil},
+		{" dev ", StabilityDev, nil},
+		{"", StabilityStable, errInvalidStability},
+		{"patch", StabilityStable, errInvalidStability},
+		{"b", StabilityStable, errInvalidStability},
+		{"foo", StabilityStable, errInvalidStability},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseStability(tt.s)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseStability(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("ParseStability(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStability_String(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []Stability{StabilityStable, StabilityRC, StabilityBeta, StabilityAlpha, StabilityDev} {
+		t.Run(s.String(), func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseStability(s.String())
+			if err != nil {
+				t.Fatalf("ParseStability(%q) error = %v", s.String(), err)
+			}
+
+			if got != s {
+				t.Errorf("ParseStability(%q) = %v, want %v", s.String(), got, s)
+			}
+		})
+	}
+}
+
+func TestVersion_Stability(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v    string
+		want Stability
+	}{
+		{"1.0.0", StabilityStable},
+		{"1.0.0-patch1", StabilityStable},
+		{"1.0.0-p", StabilityStable},
+		{"1.0.0-RC1", StabilityRC},
+		{"1.0.0-rc", StabilityRC},
+		{"1.0.0-beta2", StabilityBeta},
+		{"1.0.0-b", StabilityBeta},
+		{"1.0.0-alpha3", StabilityAlpha},
+		{"1.0.0-a", StabilityAlpha},
+		{"1.0.0-dev", StabilityDev},
+		{"1.0.0-beta1-dev", StabilityDev},
+		{"1.x-dev", StabilityDev},
+		{"dev-master", StabilityDev},
+		{"dev-feature/foo", StabilityDev},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			if got := MustParse(tt.v).Stability(); got != tt.want {
+				t.Errorf("Version(%q).Stability() = %v, want %v", tt.v, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStability_Allows(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s    Stability
+		v    string
+		want bool
+	}{
+		{StabilityStable, "1.0.0", true},
+		{StabilityStable, "1.0.0-patch1", true},
+		{StabilityStable, "1.0.0-RC1", false},
+		{StabilityRC, "1.0.0-RC1", true},
+		{StabilityRC, "1.0.0-beta1", false},
+		{StabilityBeta, "1.0.0-RC1", true},
+		{StabilityBeta, "1.0.0-beta1", true},
+		{StabilityBeta, "1.0.0-alpha1", false},
+		{StabilityAlpha, "1.0.0-alpha1", true},
+		{StabilityAlpha, "1.0.0-dev", false},
+		{StabilityDev, "1.0.0-dev", true},
+		{StabilityDev, "dev-master", true},
+		{StabilityDev, "1.0.0", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s.String()+"/"+tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.s.Allows(MustParse(tt.v)); got != tt.want {
+				t.Errorf("%v.Allows(%q) = %v, want %v", tt.s, tt.v, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterByStability(t *testing.T) {
+	t.Parallel()
+
+	vs := []Version{
+		MustParse("dev-master"),
+		MustParse("1.0.0-dev"),
+		MustParse("1.0.0-alpha1"),
+		MustParse("1.0.0-beta1"),
+		MustParse("1.0.0-RC1"),
+		MustParse("1.0.0"),
+		MustParse("1.0.1-patch1"),
+	}
+
+	tests := []struct {
+		minimum Stability
+		want    []string
+	}{
+		{StabilityStable, []string{"1.0.0", "1.0.1-patch1"}},
+		{StabilityRC, []string{"1.0.0-RC1", "1.0.0", "1.0.1-patch1"}},
+		{StabilityBeta, []string{"1.0.0-beta1", "1.0.0-RC1", "1.0.0", "1.0.1-patch1"}},
+		{StabilityAlpha, []string{"1.0.0-alpha1", "1.0.0-beta1", "1.0.0-RC1", "1.0.0", "1.0.1-patch1"}},
+		{
+			StabilityDev,
+			[]string{"dev-master", "1.0.0-dev", "1.0.0-alpha1", "1.0.0-beta1", "1.0.0-RC1", "1.0.0", "1.0.1-patch1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.minimum.String(), func(t *testing.T) {
+			t.Parallel()
+
+			got := FilterByStability(vs, tt.minimum)
+
+			gotOriginals := make([]string, 0, len(got))
+			for _, v := range got {
+				gotOriginals = append(gotOriginals, v.Original())
+			}
+
+			if !slices.Equal(gotOriginals, tt.want) {
+				t.Errorf("FilterByStability(%v) = %v, want %v", tt.minimum, gotOriginals, tt.want)
+			}
+		})
+	}
+}
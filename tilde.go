@@ -0,0 +1,31 @@
+package comver
+
+// NewTilde returns a [CeilingFloorConstrainter] instance representing the
+// [tilde version range] of the given [Version], e.g. '~1.2'; or return an
+// error if the [Version] is a named dev branch.
+//
+// Same as composer, the last given numeric component of the [Version] is
+// allowed to go up, e.g.:
+//   - '~1.2' means '>=1.2.0.0-dev <2.0.0.0-dev'
+//   - '~1.2.3' means '>=1.2.3.0-dev <1.3.0.0-dev'
+//
+// Therefore, '~1.2' and '~1.2.0' are different constraints. For versions not
+// coming from [Parse], the number of numeric components in [Version.Short] is
+// used. Named dev branches, e.g. dev-master, have no ranges, same as
+// [NewHyphenRange].
+//
+// [tilde version range]: https://getcomposer.org/doc/articles/versions.md#tilde-version-range-
+func NewTilde(v Version) (CeilingFloorConstrainter, error) { //nolint:ireturn
+	if v.isBranch() {
+		var nilC CeilingFloorConstrainter
+
+		return nilC, errBranchInterval
+	}
+
+	position := max(1, v.parts()-1)
+
+	return And(
+		NewGreaterThanOrEqualTo(v.lowestPreRelease()),
+		NewLessThan(v.bump(position).lowestPreRelease()),
+	)
+}
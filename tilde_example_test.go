@@ -0,0 +1,19 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleNewTilde() {
+	minor, _ := comver.NewTilde(comver.MustParse("1.2"))
+	patch, _ := comver.NewTilde(comver.MustParse("1.2.3"))
+
+	fmt.Println(minor)
+	fmt.Println(patch)
+
+	// Output:
+	// >=1.2-dev <2-dev
+	// >=1.2.3-dev <1.3-dev
+}
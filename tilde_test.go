@@ -0,0 +1,92 @@
+package comver
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewTilde(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v    string
+		want string
+	}{
+		// taken from composer/semver VersionParserTest::tildeConstraints()
+		// https://github.com/composer/semver/blob/1d09200268e7d1052ded8e5da9c73c96a63d18f5/tests/VersionParserTest.php
+		{"v1", ">=1-dev <2-dev"},
+		{"1.0", ">=1-dev <2-dev"},
+		{"1.0.0", ">=1-dev <1.1-dev"},
+		{"1.2", ">=1.2-dev <2-dev"},
+		{"1.2.3", ">=1.2.3-dev <1.3-dev"},
+		{"1.2.3.4", ">=1.2.3.4-dev <1.2.4-dev"},
+		{"1.2-beta", ">=1.2-beta <2-dev"},
+		{"1.2-b2", ">=1.2-beta2 <2-dev"},
+		{"1.2-BETA2", ">=1.2-beta2 <2-dev"},
+
+		// additional tests
+		{"1", ">=1-dev <2-dev"},
+		{"0.3", ">=0.3-dev <1-dev"},
+		{"1.2.3-RC1", ">=1.2.3-RC1 <1.3-dev"},
+		{"1.2.3-patch1", ">=1.2.3-patch1 <1.3-dev"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewTilde(MustParse(tt.v))
+			if err != nil {
+				t.Fatalf("NewTilde(%v) error = %v", tt.v, err)
+			}
+
+			if gotString := got.String(); gotString != tt.want {
+				t.Errorf("NewTilde(%q).String() = %q, want %q", tt.v, gotString, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTilde_zero(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		v    Version
+		want string
+	}{
+		{"zero", Version{}, ">=0-dev <1-dev"},
+		{"major", Version{major: 1}, ">=1-dev <2-dev"},
+		{"minor", Version{major: 1, minor: 2}, ">=1.2-dev <2-dev"},
+		{"patch", Version{major: 1, minor: 2, patch: 3}, ">=1.2.3-dev <1.3-dev"},
+		{"tweak", Version{major: 1, minor: 2, patch: 3, tweak: 4}, ">=1.2.3.4-dev <1.2.4-dev"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewTilde(tt.v)
+			if err != nil {
+				t.Fatalf("NewTilde(%v) error = %v", tt.v, err)
+			}
+
+			if gotString := got.String(); gotString != tt.want {
+				t.Errorf("NewTilde(%v).String() = %q, want %q", tt.v, gotString, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTilde_branch(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"dev-master", "dev-1.x"} {
+		t.Run(v, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewTilde(MustParse(v))
+			if !errors.Is(err, errBranchInterval) {
+				t.Errorf("NewTilde(%q) = %v, error = %v, wantErr %v", v, got, err, errBranchInterval)
+			}
+		})
+	}
+}
@@ -10,11 +10,14 @@ import (
 const (
 	classicalVersioningRegex               = `(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:\.(\d+))?`
 	dateOnlyVersioningRegex                = `(\d{4})(?:[.:-]?(\d{2}))(?:[.:-]?(\d{2}))?(?:\.(\d+))?`
-	modifierRegex                          = `[._-]?(?:(stable|beta|b|rc|alpha|a|patch|pl|p)((?:[.-]?\d+)+)?)?`
+	modifierRegex                          = `[._-]?(?:(stable|beta|b|rc|alpha|a|patch|pl|p)((?:[.-]?\d+)+)?)?([.-]?dev)?`
+	numericBranchRegex                     = `(\d+)(?:\.(\d+|[x*]))?(?:\.(\d+|[x*]))?(?:\.(\d+|[x*]))?[.-]?dev`
+	numericBranchWildcard                  = 9999999
 	errEmptyString             stringError = "version string is empty"
 	errInvalidVersionString    stringError = "invalid version string"
 	errNotFixedVersion         stringError = "not a fixed version"
 	errDateVersionWithFourBits stringError = "date versions with 4 bits"
+	errInvalidPreRelease       stringError = "invalid pre-release"
 )
 
 var (
@@ -24,15 +27,38 @@ var (
 	dateOnlyVersioningRegexp = regexp.MustCompile(
 		"^" + dateOnlyVersioningRegex + modifierRegex + "$",
 	)
+	numericBranchRegexp = regexp.MustCompile(
+		"^" + numericBranchRegex + "$",
+	)
+	modifierRegexp = regexp.MustCompile(
+		"^" + modifierRegex + "$",
+	)
 )
 
 // Version represents a single composer version.
 // The zero value for Version is v0.0.0.0 with empty original string.
+//
+// Dev branches are versions too. Numeric branches (e.g. 1.x-dev) are
+// normalized into dev versions (e.g. 1.9999999.9999999.9999999-dev) while
+// named branches (e.g. dev-master) are kept as is. Named branches are never
+// satisfied by ranges, see [Endless.Check].
 type Version struct {
 	major, minor, patch, tweak uint64   `exhaustruct:"optional"`
 	modifier                   modifier `exhaustruct:"optional"`
 	preRelease                 string   `exhaustruct:"optional"`
-	original                   string   `exhaustruct:"optional"`
+	// Whether the version has a dev suffix after its modifier,
+	// e.g.: true for '1.0.0-RC1-dev'.
+	devSuffix bool `exhaustruct:"optional"`
+	// The name of a named dev branch without the 'dev-' prefix,
+	// e.g.: 'master' for 'dev-master'. Empty for non-branch versions.
+	branch string `exhaustruct:"optional"`
+	// The build metadata without the '+' prefix, e.g.: 'foo.1' for
+	// '1.0.0+foo.1'. It is ignored when comparing versions.
+	metadata string `exhaustruct:"optional"`
+	original string `exhaustruct:"optional"`
+	// The number of numeric components given in the original string,
+	// e.g.: 2 for '1.2' and 3 for '1.2.0'. Zero if unknown.
+	precision uint8 `exhaustruct:"optional"`
 }
 
 // Parse parses a given version string, attempts to coerce a version string into
@@ -59,11 +85,6 @@ func Parse(v string) (Version, error) { //nolint:cyclop,funlen
 		return Version{}, &ParseError{original, errEmptyString}
 	}
 
-	v = strings.TrimPrefix(v, "v")
-	if v == "" {
-		return Version{}, &ParseError{original, errInvalidVersionString}
-	}
-
 	if strings.Contains(v, " as ") {
 		return Version{}, &ParseError{original, errNotFixedVersion}
 	}
@@ -72,25 +93,37 @@ func Parse(v string) (Version, error) { //nolint:cyclop,funlen
 		return Version{}, &ParseError{original, errNotFixedVersion}
 	}
 
-	if containsAnyOf(v, "master", "trunk", "default") {
-		return Version{}, &ParseError{original, errNotFixedVersion}
+	// normalize master/trunk/default branches to dev-name
+	if v == "master" || v == "trunk" || v == "default" {
+		return Version{branch: v, original: original}, nil //nolint:exhaustruct
 	}
 
 	if strings.HasPrefix(v, "dev-") {
-		return Version{}, &ParseError{original, errNotFixedVersion}
+		// branch names are case-sensitive
+		branch := strings.TrimSpace(original)[len("dev-"):]
+		if branch == "" {
+			return Version{}, &ParseError{original, errInvalidVersionString}
+		}
+
+		return Version{branch: branch, original: original}, nil //nolint:exhaustruct
+	}
+
+	v = strings.TrimPrefix(v, "v")
+	if v == "" {
+		return Version{}, &ParseError{original, errInvalidVersionString}
 	}
 
-	// strip off build metadata
+	// split off build metadata
 	v, metadata, _ := strings.Cut(v, "+")
 	if v == "" || strings.Contains(metadata, " ") {
 		return Version{}, &ParseError{original, errInvalidVersionString}
 	}
 
-	if strings.HasSuffix(v, "dev") {
-		return Version{}, &ParseError{original, errNotFixedVersion}
-	}
+	// build metadata are case-sensitive
+	_, metadata, _ = strings.Cut(strings.TrimSpace(original), "+")
 
 	cv := Version{
+		metadata: metadata,
 		original: original,
 	}
 
@@ -102,7 +135,17 @@ func Parse(v string) (Version, error) { //nolint:cyclop,funlen
 		match = dm
 	}
 
-	if match == nil || len(match) != 7 {
+	if match == nil {
+		if bm := numericBranchRegexp.FindStringSubmatch(v); bm != nil {
+			return parseNumericBranch(original, metadata, bm)
+		}
+
+		if strings.HasSuffix(v, "dev") {
+			return Version{}, &ParseError{original, errNotFixedVersion}
+		}
+	}
+
+	if match == nil || len(match) != 8 {
 		return Version{}, &ParseError{original, errInvalidVersionString}
 	}
 
@@ -110,8 +153,8 @@ func Parse(v string) (Version, error) { //nolint:cyclop,funlen
 	if cv.major, err = strconv.ParseUint(match[1], 10, 64); err != nil { //nolint:noinlineerr
 		return Version{}, &ParseError{original, err}
 	}
-	// CalVer (as MAJOR) must be in YYYYMMDDhhmm or YYYYMMDD formats
-	if s := strconv.FormatUint(cv.major, 10); len(s) > 12 || len(s) == 11 || len(s) == 9 || len(s) == 7 {
+
+	if !validMajor(cv.major) {
 		return Version{}, &ParseError{original, errInvalidVersionString}
 	}
 
@@ -131,11 +174,95 @@ func Parse(v string) (Version, error) { //nolint:cyclop,funlen
 		return Version{}, &ParseError{original, err}
 	}
 
-	if cv.modifier, err = newModifier(match[5]); err != nil { //nolint:noinlineerr
+	if match[5] == "stable" {
+		// same as composer, anything after the stable modifier is ignored
+		match[5], match[6], match[7] = "", "", ""
+	}
+
+	if cv, err = cv.withSuffix(match[5], match[6], match[7]); err != nil { //nolint:noinlineerr
 		return Version{}, &ParseError{original, err}
 	}
 
-	cv.preRelease = strings.TrimPrefix(strings.TrimPrefix(match[6], "-"), ".")
+	cv.precision = 1
+	for _, m := range match[2:5] {
+		if m != "" {
+			cv.precision++
+		}
+	}
+
+	return cv, nil
+}
+
+// validMajor reports whether the major is valid. CalVer (as major) must be in
+// YYYYMMDDhhmm or YYYYMMDD formats.
+func validMajor(major uint64) bool {
+	s := strconv.FormatUint(major, 10)
+
+	return len(s) <= 12 && len(s) != 11 && len(s) != 9 && len(s) != 7
+}
+
+// withSuffix returns the version with the modifier, pre-release and dev suffix
+// set from the submatches of modifierRegex, e.g.: 'beta', '.2' and '-dev' for
+// '-beta.2-dev'.
+func (v Version) withSuffix(modifier, preRelease, dev string) (Version, error) {
+	var err error
+	if v.modifier, err = newModifier(modifier); err != nil { //nolint:noinlineerr
+		return Version{}, err
+	}
+
+	v.preRelease = strings.TrimPrefix(strings.TrimPrefix(preRelease, "-"), ".")
+
+	if dev != "" {
+		if v.modifier == modifierStable {
+			v.modifier = modifierDev
+		} else {
+			v.devSuffix = true
+		}
+	}
+
+	return v, nil
+}
+
+// parseNumericBranch parses the submatches of numericBranchRegexp, e.g.:
+// '1.x-dev' into 1.9999999.9999999.9999999-dev, with the build metadata kept.
+func parseNumericBranch(original, metadata string, match []string) (Version, error) {
+	cv := Version{ //nolint:exhaustruct
+		modifier: modifierDev,
+		metadata: metadata,
+		original: original,
+	}
+
+	ps := []*uint64{&cv.major, &cv.minor, &cv.patch, &cv.tweak}
+	for i, p := range ps {
+		m := match[i+1]
+
+		if m == "" || m == "x" || m == "*" {
+			*p = numericBranchWildcard
+
+			continue
+		}
+
+		var err error
+		if *p, err = strconv.ParseUint(m, 10, 64); err != nil { //nolint:noinlineerr
+			return Version{}, &ParseError{original, err}
+		}
+
+		cv.precision++
+	}
+
+	// same as numbered versions, e.g.: '100000000.x-dev' is as invalid as '100000000'
+	if !validMajor(cv.major) {
+		return Version{}, &ParseError{original, errInvalidVersionString}
+	}
+
+	if cv.major >= 1000_00 && match[4] != "" {
+		return Version{}, &ParseError{original, errDateVersionWithFourBits}
+	}
+
+	// count the trailing wildcard, e.g.: 2 for '1.x-dev'
+	if cv.precision < 4 { //nolint:mnd
+		cv.precision++
+	}
 
 	return cv, nil
 }
@@ -150,19 +277,49 @@ func MustParse(v string) Version {
 	return cv
 }
 
-func hasSuffixAnyOf(s string, suffixes ...string) bool {
-	for _, suffix := range suffixes {
-		if strings.HasSuffix(s, suffix) {
-			return true
-		}
+// NewVersion returns a [Version] of the given numeric components and
+// pre-release, e.g.: 'beta2', 'RC1-dev' or an empty string for stable versions,
+// or return an error if the combination is not a valid composer version.
+//
+// Same as [Parse], the pre-release is case-insensitive and CalVer (as major)
+// must be in YYYYMMDDhhmm or YYYYMMDD formats without tweak.
+func NewVersion(major, minor, patch, tweak uint64, preRelease string) (Version, error) {
+	if !validMajor(major) {
+		return Version{}, errInvalidVersionString
 	}
 
-	return false
+	if major >= 1000_00 && tweak != 0 {
+		return Version{}, errDateVersionWithFourBits
+	}
+
+	m := modifierRegexp.FindStringSubmatch(strings.ToLower(preRelease))
+	if m == nil {
+		return Version{}, errInvalidPreRelease
+	}
+
+	v := Version{
+		major: major,
+		minor: minor,
+		patch: patch,
+		tweak: tweak,
+	}
+
+	return v.withSuffix(m[1], m[2], m[3])
+}
+
+// MustNewVersion is like [NewVersion] but panics if the version is invalid.
+func MustNewVersion(major, minor, patch, tweak uint64, preRelease string) Version {
+	v, err := NewVersion(major, minor, patch, tweak, preRelease)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
 }
 
-func containsAnyOf(s string, substrs ...string) bool {
-	for _, substr := range substrs {
-		if strings.Contains(s, substr) {
+func hasSuffixAnyOf(s string, suffixes ...string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(s, suffix) {
 			return true
 		}
 	}
@@ -172,32 +329,219 @@ func containsAnyOf(s string, substrs ...string) bool {
 
 // String returns the normalized string representation of the version.
 func (v Version) String() string {
-	s := fmt.Sprintf("%d.%d.%d.%d", v.major, v.minor, v.patch, v.tweak)
-
-	if v.modifier != modifierStable {
-		s += "-" + v.modifier.String() + v.preRelease
+	if v.isBranch() {
+		return "dev-" + v.branch
 	}
 
-	return s
+	s := fmt.Sprintf("%d.%d.%d.%d", v.major, v.minor, v.patch, v.tweak)
+
+	return s + v.suffix()
 }
 
 // Short returns the shortest string representation of the version.
 func (v Version) Short() string {
+	if v.isBranch() {
+		return "dev-" + v.branch
+	}
+
 	s := fmt.Sprintf("%d.%d.%d.%d", v.major, v.minor, v.patch, v.tweak)
 
 	s = strings.TrimSuffix(s, ".0")
 	s = strings.TrimSuffix(s, ".0")
 	s = strings.TrimSuffix(s, ".0")
 
+	return s + v.suffix()
+}
+
+// StringWithMetadata is like [Version.String] but with the build metadata
+// appended, e.g.: '1.2.3.0+foo.1' for '1.2.3+foo.1'.
+func (v Version) StringWithMetadata() string {
+	return v.String() + v.metadataSuffix()
+}
+
+// ShortWithMetadata is like [Version.Short] but with the build metadata
+// appended, e.g.: '1.2.3+foo.1' for '1.2.3.0+foo.1'.
+func (v Version) ShortWithMetadata() string {
+	return v.Short() + v.metadataSuffix()
+}
+
+func (v Version) metadataSuffix() string {
+	if v.metadata == "" {
+		return ""
+	}
+
+	return "+" + v.metadata
+}
+
+func (v Version) suffix() string {
+	var s string
+
 	if v.modifier != modifierStable {
 		s += "-" + v.modifier.String() + v.preRelease
 	}
 
+	if v.devSuffix {
+		s += "-dev"
+	}
+
 	return s
 }
 
+// isBranch reports whether the version is a named dev branch, e.g. dev-master.
+func (v Version) isBranch() bool {
+	return v.branch != ""
+}
+
+// isNumericBranch reports whether the version is a numeric dev branch, e.g.
+// 1.x-dev, i.e. a dev version with wildcard components.
+func (v Version) isNumericBranch() bool {
+	return v.modifier == modifierDev && v.preRelease == "" && !v.devSuffix &&
+		v.tweak == numericBranchWildcard
+}
+
+// lowestPreRelease returns the lowest pre-release of a stable version, i.e. the
+// version with dev modifier. Non-stable versions and named dev branches are
+// returned as is.
+//
+// Composer uses it to lower range bounds so that pre-releases are included,
+// e.g.: '>=1.0' means '>=1.0.0.0-dev'.
+func (v Version) lowestPreRelease() Version {
+	if v.modifier != modifierStable || v.isBranch() {
+		return v
+	}
+
+	v.modifier = modifierDev
+	v.preRelease = ""
+	v.metadata = ""
+	v.original = ""
+
+	return v
+}
+
+// truncate returns the stable version with only the numeric components up to
+// the given position (1 for major, 4 for tweak), all less significant
+// components are reset to zero.
+func (v Version) truncate(position int) Version {
+	w := Version{ //nolint:exhaustruct
+		major:     v.major,
+		precision: uint8(position), //nolint:gosec
+	}
+
+	if position >= 2 { //nolint:mnd
+		w.minor = v.minor
+	}
+
+	if position >= 3 { //nolint:mnd
+		w.patch = v.patch
+	}
+
+	if position >= 4 { //nolint:mnd
+		w.tweak = v.tweak
+	}
+
+	return w
+}
+
+// bump returns the stable version with the numeric component at the given
+// position (1 for major, 4 for tweak) incremented by one and all less
+// significant components reset to zero.
+func (v Version) bump(position int) Version {
+	w := v.truncate(position)
+
+	switch position {
+	case 1:
+		w.major++
+	case 2: //nolint:mnd
+		w.minor++
+	case 3: //nolint:mnd
+		w.patch++
+	default:
+		w.tweak++
+	}
+
+	return w
+}
+
+// parts returns the number of numeric components given in the original
+// string. For versions not coming from [Parse], it falls back to the number
+// of numeric components in [Version.Short].
+func (v Version) parts() int {
+	switch {
+	case v.precision != 0:
+		return int(v.precision)
+	case v.tweak != 0:
+		return 4 //nolint:mnd
+	case v.patch != 0:
+		return 3 //nolint:mnd
+	case v.minor != 0:
+		return 2 //nolint:mnd
+	default:
+		return 1
+	}
+}
+
+// Major returns the major component of the version, e.g.: 1 for '1.2.3.4'.
+// Zero is returned for named dev branches.
+func (v Version) Major() uint64 {
+	return v.major
+}
+
+// Minor returns the minor component of the version, e.g.: 2 for '1.2.3.4'.
+// Zero is returned for named dev branches.
+func (v Version) Minor() uint64 {
+	return v.minor
+}
+
+// Patch returns the patch component of the version, e.g.: 3 for '1.2.3.4'.
+// Zero is returned for named dev branches.
+func (v Version) Patch() uint64 {
+	return v.patch
+}
+
+// Tweak returns the tweak component of the version, e.g.: 4 for '1.2.3.4'.
+// Zero is returned for named dev branches.
+func (v Version) Tweak() uint64 {
+	return v.tweak
+}
+
+// PreRelease returns the normalized pre-release of the version, e.g.: 'beta2'
+// for '1.0.0-b.2' and 'RC1-dev' for '1.0.0-rc1-dev'. Patch modifiers are
+// returned too although their versions are stable, e.g.: 'patch1' for
+// '1.0.0-patch1'. Empty string is returned for named dev branches and stable
+// versions without patch modifiers.
+func (v Version) PreRelease() string {
+	return strings.TrimPrefix(v.suffix(), "-")
+}
+
+// Metadata returns the build metadata without the '+' prefix, e.g.: 'foo.1'
+// for '1.2.3+foo.1'. Empty string is returned when there is no build metadata.
+//
+// Same as [semantic versioning], build metadata are ignored when comparing
+// versions. Thus, they are not included in [Version.String] and
+// [Version.Short].
+//
+// [semantic versioning]: https://semver.org/#spec-item-10
+func (v Version) Metadata() string {
+	return v.metadata
+}
+
+// WithMetadata returns a copy of the version with the given build metadata,
+// or return an error if the build metadata contains spaces or the version is a
+// named dev branch. An empty string removes the build metadata.
+func (v Version) WithMetadata(metadata string) (Version, error) {
+	if v.isBranch() || strings.Contains(metadata, " ") {
+		return Version{}, errInvalidVersionString
+	}
+
+	v.metadata = metadata
+	v.original = ""
+
+	return v, nil
+}
+
 // Original returns the original version string passed into [Parse].
-// Empty string is returned when [Version] is the zero value.
+// Empty string is returned when [Version] is the zero value or not from
+// [Parse], e.g.: from [NewVersion].
 func (v Version) Original() string {
 	return v.original
 }
@@ -205,13 +549,24 @@ func (v Version) Original() string {
 // Compare returns an integer comparing two [Version] instances.
 //
 // Pre-release versions are compared according to [semantic version precedence].
+// Dev versions have the lowest precedence among the same numbered versions,
+// e.g.: 1.0.0-dev < 1.0.0-alpha-dev < 1.0.0-alpha. Named dev branches have
+// higher precedence than any numbered versions.
 // The result is 0 when v == w, -1 when v < w, or +1 when v > w.
 //
 // [semantic version precedence]: https://semver.org/#spec-item-11
-func (v Version) Compare(w Version) int { //nolint:cyclop,funlen
+func (v Version) Compare(w Version) int { //nolint:cyclop
 	switch {
 	case v.String() == w.String():
 		return 0
+	// named dev branches have higher precedence than any numbered versions
+	// and are compared lexically in ASCII sort order among themselves
+	case v.isBranch() && w.isBranch():
+		return strings.Compare(v.branch, w.branch)
+	case v.isBranch():
+		return +1
+	case w.isBranch():
+		return -1
 	case v.major > w.major:
 		return +1
 	case v.major < w.major:
@@ -232,14 +587,35 @@ func (v Version) Compare(w Version) int { //nolint:cyclop,funlen
 		return +1
 	case v.modifier < w.modifier:
 		return -1
-	case v.preRelease != "" && w.preRelease == "":
+	}
+
+	if cmp := comparePreRelease(v.preRelease, w.preRelease); cmp != 0 {
+		return cmp
+	}
+
+	// dev suffix has lower precedence, e.g.: 1.0.0-RC1-dev < 1.0.0-RC1
+	switch {
+	case v.devSuffix && !w.devSuffix:
+		return -1
+	case !v.devSuffix && w.devSuffix:
+		return +1
+	default:
+		return 0
+	}
+}
+
+func comparePreRelease(v, w string) int { //nolint:cyclop
+	switch {
+	case v == w:
+		return 0
+	case v != "" && w == "":
 		return +1
-	case v.preRelease == "" && w.preRelease != "":
+	case v == "" && w != "":
 		return -1
 	}
 
-	vPres := strings.Split(v.preRelease, ".")
-	wPres := strings.Split(w.preRelease, ".")
+	vPres := strings.Split(v, ".")
+	wPres := strings.Split(w, ".")
 
 	// comparing each dot separated identifier from ceiling to floor
 	for i := range vPres {
@@ -141,3 +141,46 @@ func ExampleVersion_Original() {
 	// "1.2.3.4-beta5+foo" => 1.2.3.4-beta5+foo
 	// "1.b5+foo"          => 1.b5+foo
 }
+
+func ExampleNewVersion() {
+	v, _ := comver.NewVersion(1, 2, 3, 0, "beta2")
+
+	fmt.Println(v)
+	fmt.Println(v.Compare(comver.MustParse("1.2.3-b.2")))
+	// Output:
+	// 1.2.3.0-beta2
+	// 0
+}
+
+func ExampleNewVersion_error() {
+	_, err := comver.NewVersion(1, 2, 3, 0, "foo")
+
+	fmt.Println(err)
+	// Output: invalid pre-release
+}
+
+func ExampleVersion_Major() {
+	v := comver.MustParse("1.2.3.4-RC5")
+
+	fmt.Println(v.Major(), v.Minor(), v.Patch(), v.Tweak())
+	fmt.Println(v.PreRelease())
+	fmt.Println(v.Stability())
+	// Output:
+	// 1 2 3 4
+	// RC5
+	// RC
+}
+
+func ExampleVersion_Metadata() {
+	v := comver.MustParse("1.2.3-beta.5+exp.sha.5114f85")
+
+	fmt.Println(v.Metadata())
+	fmt.Println(v.String())
+	fmt.Println(v.StringWithMetadata())
+	fmt.Println(v.ShortWithMetadata())
+	// Output:
+	// exp.sha.5114f85
+	// 1.2.3.0-beta5
+	// 1.2.3.0-beta5+exp.sha.5114f85
+	// 1.2.3-beta5+exp.sha.5114f85
+}
@@ -71,6 +71,26 @@ func goodVersionTestCases() []struct {
 		{"keep zero-padding/4", "0700", "700.0.0.0"},
 		{"space padding", " 1.0.0", "1.0.0.0"},
 		{"space padding/2", "1.0.0 ", "1.0.0.0"},
+		{"parses state", "1.0.0RC1dev", "1.0.0.0-RC1-dev"},
+		{"CI parsing", "1.0.0-rC15-dev", "1.0.0.0-RC15-dev"},
+		{"delimiters", "1.0.0.RC.15-dev", "1.0.0.0-RC15-dev"},
+		{"patch replace", "1.0.0.pl3-dev", "1.0.0.0-patch3-dev"},
+		{"forces w.x.y.z", "1.0-dev", "1.0.0.0-dev"},
+		{"parses date dev", "20100102.x-dev", "20100102.9999999.9999999.9999999-dev"},
+		{"parses datetime dev", "20100102.203040.x-dev", "20100102.203040.9999999.9999999-dev"},
+		{"parses dt Ym dev", "201903.x-dev", "201903.9999999.9999999.9999999-dev"},
+		{"parses master", "dev-master", "dev-master"},
+		{"parses master w/o dev", "master", "dev-master"},
+		{"parses trunk", "dev-trunk", "dev-trunk"},
+		{"parses branches", "1.x-dev", "1.9999999.9999999.9999999-dev"},
+		{"parses arbitrary", "dev-feature-foo", "dev-feature-foo"},
+		{"parses arbitrary/2", "DEV-FOOBAR", "dev-FOOBAR"},
+		{"parses arbitrary/3", "dev-feature/foo", "dev-feature/foo"},
+		{"parses arbitrary/4", "dev-feature+issue-1", "dev-feature+issue-1"},
+		{"keep zero-padding/5", "041.x-dev", "41.9999999.9999999.9999999-dev"},
+		{"keep zero-padding/6", "dev-041.003", "dev-041.003"},
+		{"dev with mad name", "dev-1.0.0-dev<1.0.5-dev", "dev-1.0.0-dev<1.0.5-dev"},
+		{"dev prefix with spaces", "dev-foo bar", "dev-foo bar"},
 
 		// taken from https://semver.org/#spec-item-11
 		{"semver pre-release/1", "1.0.0-alpha", "1.0.0.0-alpha"},
@@ -82,6 +102,15 @@ func goodVersionTestCases() []struct {
 
 		// additional tests
 		{"parses dates y-m", "2010-01", "2010.1.0.0"},
+		{"dev", "1.0.0-dev", "1.0.0.0-dev"},
+		{"dev without hyphen", "1.0.0dev", "1.0.0.0-dev"},
+		{"dev with dot", "1.0.0.dev", "1.0.0.0-dev"},
+		{"alpha dev", "1.0.0-alpha-dev", "1.0.0.0-alpha-dev"},
+		{"stable", "1.0.0-stable", "1.0.0.0"},
+		{"parses minor branches", "1.2.x-dev", "1.2.9999999.9999999-dev"},
+		{"parses wildcard branches", "1.*-dev", "1.9999999.9999999.9999999-dev"},
+		{"parses default", "default", "dev-default"},
+		{"parses branches with spaces", " dev-foo ", "dev-foo"},
 	}
 }
 
@@ -141,27 +170,11 @@ func badVersionTestCases() []struct {
 		// composer/semver supports a lot of different version formats, but we only support a subset of them
 		// taken from composer/semver VersionParserTest::successfulNormalizedVersions()
 		// https://github.com/composer/semver/blob/1d09200268e7d1052ded8e5da9c73c96a63d18f5/tests/VersionParserTest.php#L65-L142
-		{"parses state", "1.0.0RC1dev", errNotFixedVersion},
-		{"CI parsing", "1.0.0-rC15-dev", errNotFixedVersion},
-		{"delimiters", "1.0.0.RC.15-dev", errNotFixedVersion},
-		{"patch replace", "1.0.0.pl3-dev", errNotFixedVersion},
-		{"forces w.x.y.z", "1.0-dev", errNotFixedVersion},
 		{"parses dates w/ - and .", "2010-01-02-10-20-30.0.3", errInvalidVersionString},
 		{"parses dates w/ - and ./2", "2010-01-02-10-20-30.5", errInvalidVersionString},
 		{"parses datetime", "20100102-203040", errInvalidVersionString},
-		{"parses date dev", "20100102.x-dev", errNotFixedVersion},
-		{"parses datetime dev", "20100102.203040.x-dev", errNotFixedVersion},
 		{"parses dt+number", "20100102203040-10", errInvalidVersionString},
 		{"parses dt+patch", "20100102-203040-p1", errInvalidVersionString},
-		{"parses dt Ym dev", "201903.x-dev", errNotFixedVersion},
-		{"parses master", "dev-master", errNotFixedVersion},
-		{"parses master w/o dev", "master", errNotFixedVersion},
-		{"parses trunk", "dev-trunk", errNotFixedVersion},
-		{"parses branches", "1.x-dev", errNotFixedVersion},
-		{"parses arbitrary", "dev-feature-foo", errNotFixedVersion},
-		{"parses arbitrary/2", "DEV-FOOBAR", errNotFixedVersion},
-		{"parses arbitrary/3", "dev-feature/foo", errNotFixedVersion},
-		{"parses arbitrary/4", "dev-feature+issue-1", errNotFixedVersion},
 		{"ignores aliases", "dev-master as 1.0.0", errNotFixedVersion},
 		{"ignores aliases/2", "dev-load-varnish-only-when-used as ^2.0", errNotFixedVersion},
 		{
@@ -182,10 +195,6 @@ func badVersionTestCases() []struct {
 			errInvalidVersionString,
 		}, // composer/semver doesn't support this
 		{"metadata w/ alias", "1.0.0+foo as 2.0", errNotFixedVersion},
-		{"keep zero-padding/5", "041.x-dev", errNotFixedVersion},
-		{"keep zero-padding/6", "dev-041.003", errNotFixedVersion},
-		{"dev with mad name", "dev-1.0.0-dev<1.0.5-dev", errNotFixedVersion},
-		{"dev prefix with spaces", "dev-foo bar", errNotFixedVersion},
 
 		// composer/semver doesn't support these
 		// taken from composer/semver VersionParserTest::failingNormalizedVersions()
@@ -197,6 +206,8 @@ func badVersionTestCases() []struct {
 		{"non-dev arbitrary", "feature-foo", errInvalidVersionString},
 		{"metadata w/ space", "1.0.0+foo bar", errInvalidVersionString},
 		{"maven style release", "1.0.1-SNAPSHOT", errInvalidVersionString},
+		{"just dev prefix", "dev-", errInvalidVersionString},
+		{"master as suffix", "1.0-master", errInvalidVersionString},
 		{"dev with less than", "1.0.0<1.0.5-dev", errNotFixedVersion},
 		{"dev with less than/2", "1.0.0-dev<1.0.5-dev", errNotFixedVersion},
 		{"dev suffix with spaces", "foo bar-dev", errNotFixedVersion},
@@ -212,7 +223,12 @@ func badVersionTestCases() []struct {
 		{"constraint/3", "1.*", errInvalidVersionString},
 		{"date versions with 4 bits", "20100102.0.3.4", errDateVersionWithFourBits},
 		{"date versions with 4 bits/earliest year", "100000.0.0.0", errDateVersionWithFourBits},
+		{"date numeric branches with 4 bits", "20100102.0.3.x-dev", errDateVersionWithFourBits},
+		{"date numeric branches with 4 bits/dev", "20100102.0.3.4-dev", errDateVersionWithFourBits},
 		{"invalid CalVer (as MAJOR) versions/YYYYMMD", "2023013.0.0", errInvalidVersionString},
+		{"invalid CalVer (as MAJOR) numeric branches/YYYYMMD", "2023013.x-dev", errInvalidVersionString},
+		{"invalid CalVer (as MAJOR) numeric branches/YYYYMMDDh", "100000000.x-dev", errInvalidVersionString},
+		{"invalid CalVer (as MAJOR) numeric branches/too long", "2023013100000.x-dev", errInvalidVersionString},
 		{"invalid CalVer (as MAJOR) versions/YYYYMMDDh", "202301311.0.0", errInvalidVersionString},
 		{
 			"invalid CalVer (as MAJOR) versions/YYYYMMDDhhm",
@@ -334,6 +350,23 @@ func TestVersion_Compare(t *testing.T) {
 		{"1", "1-patch", -1},
 		{"1-patch2", "1-patch11", -1},
 
+		{"1-dev", "1-alpha", -1},
+		{"1-dev", "1-alpha-dev", -1},
+		{"1-alpha-dev", "1-alpha", -1},
+		{"1-RC1-dev", "1-RC1", -1},
+		{"1-RC0", "1-RC1-dev", -1},
+		{"1-patch1-dev", "1-patch1", -1},
+		{"1-dev", "1-dev", 0},
+		{"0.9", "1-dev", -1},
+		{"1.x-dev", "2-dev", -1},
+		{"1.9999999", "1.x-dev", -1},
+		{"1.2.x-dev", "1.x-dev", -1},
+		{"99999", "dev-master", -1},
+		{"1.x-dev", "dev-master", -1},
+		{"dev-bar", "dev-foo", -1},
+		{"dev-master", "master", 0},
+		{"dev-foo", "dev-foo", 0},
+
 		// taken from https://semver.org/#spec-item-11
 		{"1.0.0-alpha", "1.0.0-alpha.1", -1},
 		{"1.0.0-alpha.1", "1.0.0-beta", -1},
@@ -490,6 +523,13 @@ func TestVersion_Short(t *testing.T) {
 		{"1.2.3-beta999", "1.2.3-beta999"},
 		{"1.2.3.0-beta999", "1.2.3-beta999"},
 		{"1.2.3.4-beta999", "1.2.3.4-beta999"},
+
+		{"1-dev", "1-dev"},
+		{"1.2.3-dev", "1.2.3-dev"},
+		{"1.2.3RC1dev", "1.2.3-RC1-dev"},
+		{"1.x-dev", "1.9999999.9999999.9999999-dev"},
+		{"dev-master", "dev-master"},
+		{"DEV-FOO", "dev-FOO"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.v, func(t *testing.T) {
@@ -524,3 +564,250 @@ func TestVersion_zero(t *testing.T) {
 		t.Errorf("Version{}.Original() = %q, want %q", got, "")
 	}
 }
+
+func TestVersion_accessors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v              string
+		major          uint64
+		minor          uint64
+		patch          uint64
+		tweak          uint64
+		wantPreRelease string
+		wantStability  Stability
+	}{
+		{"1.2.3.4", 1, 2, 3, 4, "", StabilityStable},
+		{"1.2", 1, 2, 0, 0, "", StabilityStable},
+		{"1.0.0-b.2", 1, 0, 0, 0, "beta2", StabilityBeta},
+		{"1.0.0-rc1-dev", 1, 0, 0, 0, "RC1-dev", StabilityDev},
+		{"1.0.0-alpha", 1, 0, 0, 0, "alpha", StabilityAlpha},
+		{"1.0.0-pl3", 1, 0, 0, 0, "patch3", StabilityStable},
+		{"1.0.0-patch1", 1, 0, 0, 0, "patch1", StabilityStable},
+		{"1.x-dev", 1, 9999999, 9999999, 9999999, "dev", StabilityDev},
+		{"20240102", 20240102, 0, 0, 0, "", StabilityStable},
+		{"dev-master", 0, 0, 0, 0, "", StabilityDev},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			v := MustParse(tt.v)
+
+			if got := v.Major(); got != tt.major {
+				t.Errorf("Major() = %d, want %d", got, tt.major)
+			}
+
+			if got := v.Minor(); got != tt.minor {
+				t.Errorf("Minor() = %d, want %d", got, tt.minor)
+			}
+
+			if got := v.Patch(); got != tt.patch {
+				t.Errorf("Patch() = %d, want %d", got, tt.patch)
+			}
+
+			if got := v.Tweak(); got != tt.tweak {
+				t.Errorf("Tweak() = %d, want %d", got, tt.tweak)
+			}
+
+			if got := v.PreRelease(); got != tt.wantPreRelease {
+				t.Errorf("PreRelease() = %q, want %q", got, tt.wantPreRelease)
+			}
+
+			if got := v.Stability(); got != tt.wantStability {
+				t.Errorf("Stability() = %v, want %v", got, tt.wantStability)
+			}
+		})
+	}
+}
+
+func TestNewVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		major      uint64
+		minor      uint64
+		patch      uint64
+		tweak      uint64
+		preRelease string
+		want       string
+		wantErr    error
+	}{
+		{"stable", 1, 2, 3, 4, "", "1.2.3.4", nil},
+		{"zero", 0, 0, 0, 0, "", "0.0.0.0", nil},
+		{"beta", 1, 0, 0, 0, "beta2", "1.0.0.0-beta2", nil},
+		{"beta short", 1, 0, 0, 0, "b.2", "1.0.0.0-beta2", nil},
+		{"RC dev", 1, 0, 0, 0, "RC1-dev", "1.0.0.0-RC1-dev", nil},
+		{"leading separator", 1, 0, 0, 0, "-alpha", "1.0.0.0-alpha", nil},
+		{"patch", 1, 0, 0, 0, "pl3", "1.0.0.0-patch3", nil},
+		{"dev", 1, 0, 0, 0, "dev", "1.0.0.0-dev", nil},
+		{"stable modifier", 1, 0, 0, 0, "stable", "", errUnexpectedModifier},
+		{"date", 20240102, 0, 0, 0, "", "20240102.0.0.0", nil},
+		{"date time", 202401021530, 1, 0, 0, "", "202401021530.1.0.0", nil},
+		{"invalid pre-release", 1, 0, 0, 0, "foo", "", errInvalidPreRelease},
+		{"invalid semver pre-release", 1, 0, 0, 0, "alpha.beta", "", errInvalidPreRelease},
+		{"build metadata", 1, 0, 0, 0, "beta+foo", "", errInvalidPreRelease},
+		{"7 digits major", 2024010, 0, 0, 0, "", "", errInvalidVersionString},
+		{"13 digits major", 2024010215300, 0, 0, 0, "", "", errInvalidVersionString},
+		{"date with tweak", 20240102, 0, 0, 1, "", "", errDateVersionWithFourBits},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewVersion(tt.major, tt.minor, tt.patch, tt.tweak, tt.preRelease)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("NewVersion() got = %q, want %q", got, tt.want)
+			}
+
+			if p := MustParse(got.Short()); got.Compare(p) != 0 {
+				t.Errorf("NewVersion() got = %q, not equal to parsed %q", got, p)
+			}
+
+			if got.Original() != "" {
+				t.Errorf("NewVersion().Original() = %q, want empty", got.Original())
+			}
+		})
+	}
+}
+
+func TestMustNewVersion_panic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		err := recover()
+		if err == nil {
+			t.Fatal("MustNewVersion() doesn't panic")
+		}
+
+		e, ok := err.(error)
+		if !ok || !errors.Is(e, errInvalidPreRelease) {
+			t.Errorf("MustNewVersion() panic = %v, want %v", err, errInvalidPreRelease)
+		}
+	}()
+
+	MustNewVersion(1, 0, 0, 0, "foo")
+}
+
+func TestVersion_Metadata(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v                      string
+		want                   string
+		wantStringWithMetadata string
+		wantShortWithMetadata  string
+	}{
+		{"1.0.0", "", "1.0.0.0", "1"},
+		{"1.0.0+foo", "foo", "1.0.0.0+foo", "1+foo"},
+		{"v1.2.3-beta.5+Foo.1", "Foo.1", "1.2.3.0-beta5+Foo.1", "1.2.3-beta5+Foo.1"},
+		{" 1.2+exp.sha.5114f85 ", "exp.sha.5114f85", "1.2.0.0+exp.sha.5114f85", "1.2+exp.sha.5114f85"},
+		{"1.0.0+foo+bar", "foo+bar", "1.0.0.0+foo+bar", "1+foo+bar"},
+		{"1.0.0+", "", "1.0.0.0", "1"},
+		{"dev-master", "", "dev-master", "dev-master"},
+		{"1.x-dev+foo", "foo", "1.9999999.9999999.9999999-dev+foo", "1.9999999.9999999.9999999-dev+foo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			v := MustParse(tt.v)
+
+			if got := v.Metadata(); got != tt.want {
+				t.Errorf("Metadata() = %q, want %q", got, tt.want)
+			}
+
+			if got := v.StringWithMetadata(); got != tt.wantStringWithMetadata {
+				t.Errorf("StringWithMetadata() = %q, want %q", got, tt.wantStringWithMetadata)
+			}
+
+			if got := v.ShortWithMetadata(); got != tt.wantShortWithMetadata {
+				t.Errorf("ShortWithMetadata() = %q, want %q", got, tt.wantShortWithMetadata)
+			}
+
+			w := MustParse(v.StringWithMetadata())
+
+			if w.Metadata() != v.Metadata() || w.Compare(v) != 0 {
+				t.Errorf("Parse(StringWithMetadata()) = %q, want %q", w.StringWithMetadata(), tt.wantStringWithMetadata)
+			}
+		})
+	}
+}
+
+func TestVersion_WithMetadata(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v        string
+		metadata string
+		want     string
+		wantErr  error
+	}{
+		{"1.0.0", "foo", "1.0.0.0+foo", nil},
+		{"1.0.0+foo", "bar", "1.0.0.0+bar", nil},
+		{"1.0.0+foo", "", "1.0.0.0", nil},
+		{"1.0.0", "foo bar", "", errInvalidVersionString},
+		{"dev-master", "foo", "", errInvalidVersionString},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v+"+"+tt.metadata, func(t *testing.T) {
+			t.Parallel()
+
+			v := MustParse(tt.v)
+
+			got, err := v.WithMetadata(tt.metadata)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("WithMetadata() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.StringWithMetadata() != tt.want {
+				t.Errorf("WithMetadata() got = %q, want %q", got.StringWithMetadata(), tt.want)
+			}
+
+			if got.Compare(v) != 0 {
+				t.Errorf("WithMetadata() got = %q, not equal to %q", got, v)
+			}
+		})
+	}
+}
+
+func TestVersion_Metadata_dropped(t *testing.T) {
+	t.Parallel()
+
+	v := MustParse("1.2.3-beta2+foo")
+
+	next, err := v.NextPreRelease()
+	if err != nil {
+		t.Fatalf("NextPreRelease() error = %v", err)
+	}
+
+	if got := next.Metadata(); got != "" {
+		t.Errorf("NextPreRelease().Metadata() = %q, want empty", got)
+	}
+
+	patch, err := v.NextPatch()
+	if err != nil {
+		t.Fatalf("NextPatch() error = %v", err)
+	}
+
+	if got := patch.Metadata(); got != "" {
+		t.Errorf("NextPatch().Metadata() = %q, want empty", got)
+	}
+
+	if got := MustParse("1.2.3+foo").lowestPreRelease().Metadata(); got != "" {
+		t.Errorf("lowestPreRelease().Metadata() = %q, want empty", got)
+	}
+}
@@ -0,0 +1,96 @@
+package comver
+
+// Visitor visits the [Constrainter] instances, e.g.: to translate them into
+// other formats, see [Constrainter.Accept].
+//
+// Composite constraints, i.e.: [Or], [AllOf], [AnyOf] and [FlaggedConstraint],
+// are visited as a whole. It is up to the Visitor whether to visit their
+// children by calling [Constrainter.Accept] on them.
+type Visitor interface {
+	// VisitEndless visits an [Endless] which is not a match all.
+	VisitEndless(e Endless)
+	// VisitExact visits an [ExactConstraint].
+	VisitExact(e ExactConstraint)
+	// VisitInterval visits an [Interval].
+	VisitInterval(i Interval)
+	// VisitOr visits a non-empty [Or].
+	VisitOr(o Or)
+	// VisitMatchAll visits a match all [Endless].
+	VisitMatchAll()
+	// VisitMatchNone visits a [MatchNone] or the zero value [Or].
+	VisitMatchNone()
+	// VisitNotEqual visits a [NotEqual].
+	VisitNotEqual(n NotEqual)
+	// VisitAllOf visits an [AllOf].
+	VisitAllOf(a AllOf)
+	// VisitAnyOf visits an [AnyOf].
+	VisitAnyOf(a AnyOf)
+	// VisitFlagged visits a [FlaggedConstraint].
+	VisitFlagged(f FlaggedConstraint)
+}
+
+// Accept calls [Visitor.VisitMatchAll] for match all, or
+// [Visitor.VisitEndless] otherwise.
+func (b Endless) Accept(v Visitor) {
+	if b.matchAll() {
+		v.VisitMatchAll()
+
+		return
+	}
+
+	v.VisitEndless(b)
+}
+
+// Accept calls [Visitor.VisitExact].
+func (e ExactConstraint) Accept(v Visitor) {
+	v.VisitExact(e)
+}
+
+// Accept calls [Visitor.VisitMatchAll] for the zero value Interval, or
+// [Visitor.VisitInterval] otherwise.
+func (i Interval) Accept(v Visitor) {
+	if matchAll(i) {
+		v.VisitMatchAll()
+
+		return
+	}
+
+	v.VisitInterval(i)
+}
+
+// Accept calls [Visitor.VisitMatchNone] for the zero value Or, or
+// [Visitor.VisitOr] otherwise.
+func (o Or) Accept(v Visitor) {
+	if len(o) == 0 {
+		v.VisitMatchNone()
+
+		return
+	}
+
+	v.VisitOr(o)
+}
+
+// Accept calls [Visitor.VisitMatchNone].
+func (MatchNone) Accept(v Visitor) {
+	v.VisitMatchNone()
+}
+
+// Accept calls [Visitor.VisitNotEqual].
+func (n NotEqual) Accept(v Visitor) {
+	v.VisitNotEqual(n)
+}
+
+// Accept calls [Visitor.VisitAllOf].
+func (a AllOf) Accept(v Visitor) {
+	v.VisitAllOf(a)
+}
+
+// Accept calls [Visitor.VisitAnyOf].
+func (a AnyOf) Accept(v Visitor) {
+	v.VisitAnyOf(a)
+}
+
+// Accept calls [Visitor.VisitFlagged].
+func (f FlaggedConstraint) Accept(v Visitor) {
+	v.VisitFlagged(f)
+}
@@ -0,0 +1,73 @@
+package comver_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/typisttech/comver"
+)
+
+// sqlVisitor translates constraints into SQL conditions on a version column,
+// assuming the column is comparable in version order.
+type sqlVisitor struct {
+	b strings.Builder
+}
+
+func (s *sqlVisitor) bound(b comver.Bound, op string) {
+	if b.Inclusive() {
+		op += "="
+	}
+
+	fmt.Fprintf(&s.b, "v %s '%s'", op, b.Version())
+}
+
+func (s *sqlVisitor) VisitEndless(e comver.Endless) {
+	if !e.Lower().Unbounded() {
+		s.bound(e.Lower(), ">")
+
+		return
+	}
+
+	s.bound(e.Upper(), "<")
+}
+
+func (s *sqlVisitor) VisitExact(e comver.ExactConstraint) {
+	fmt.Fprintf(&s.b, "v = '%s'", e.Lower().Version())
+}
+
+func (s *sqlVisitor) VisitInterval(i comver.Interval) {
+	s.b.WriteString("(")
+	s.bound(i.Lower(), ">")
+	s.b.WriteString(" AND ")
+	s.bound(i.Upper(), "<")
+	s.b.WriteString(")")
+}
+
+func (s *sqlVisitor) VisitOr(o comver.Or) {
+	i := 0
+	for c := range o.Intervals() {
+		if i > 0 {
+			s.b.WriteString(" OR ")
+		}
+
+		c.Accept(s)
+		i++
+	}
+}
+
+func (s *sqlVisitor) VisitMatchAll()                          { s.b.WriteString("TRUE") }
+func (s *sqlVisitor) VisitMatchNone()                         { s.b.WriteString("FALSE") }
+func (s *sqlVisitor) VisitNotEqual(n comver.NotEqual)         { n.Or().Accept(s) }
+func (s *sqlVisitor) VisitAllOf(a comver.AllOf)               { comver.Normalize(a).Accept(s) }
+func (s *sqlVisitor) VisitAnyOf(a comver.AnyOf)               { comver.Normalize(a).Accept(s) }
+func (s *sqlVisitor) VisitFlagged(f comver.FlaggedConstraint) { f.Constraint().Accept(s) }
+
+func ExampleVisitor() {
+	c := comver.MustParseConstraint("<1.0 || 1.5 || ^2.0")
+
+	s := &sqlVisitor{}
+	c.Accept(s)
+
+	fmt.Println(s.b.String())
+	// Output: v < '1.0.0.0-dev' OR v = '1.5.0.0' OR (v >= '2.0.0.0-dev' AND v < '3.0.0.0-dev')
+}
@@ -0,0 +1,139 @@
+package comver
+
+import (
+	"strings"
+	"testing"
+)
+
+// recordingVisitor records the visited types, recursing into composites.
+type recordingVisitor struct {
+	visited []string
+}
+
+func (r *recordingVisitor) VisitEndless(e Endless) {
+	r.visited = append(r.visited, "endless("+e.String()+")")
+}
+
+func (r *recordingVisitor) VisitExact(e ExactConstraint) {
+	r.visited = append(r.visited, "exact("+e.String()+")")
+}
+
+func (r *recordingVisitor) VisitInterval(i Interval) {
+	r.visited = append(r.visited, "interval("+i.String()+")")
+}
+
+func (r *recordingVisitor) VisitOr(o Or) {
+	r.visited = append(r.visited, "or")
+	for c := range o.Intervals() {
+		c.Accept(r)
+	}
+}
+
+func (r *recordingVisitor) VisitMatchAll() {
+	r.visited = append(r.visited, "match_all")
+}
+
+func (r *recordingVisitor) VisitMatchNone() {
+	r.visited = append(r.visited, "match_none")
+}
+
+func (r *recordingVisitor) VisitNotEqual(n NotEqual) {
+	r.visited = append(r.visited, "not_equal("+n.String()+")")
+}
+
+func (r *recordingVisitor) VisitAllOf(a AllOf) {
+	r.visited = append(r.visited, "all_of")
+	for _, c := range a {
+		c.Accept(r)
+	}
+}
+
+func (r *recordingVisitor) VisitAnyOf(a AnyOf) {
+	r.visited = append(r.visited, "any_of")
+	for _, c := range a {
+		c.Accept(r)
+	}
+}
+
+func (r *recordingVisitor) VisitFlagged(f FlaggedConstraint) {
+	r.visited = append(r.visited, "flagged("+f.Stability().String()+")")
+	f.Constraint().Accept(r)
+}
+
+func TestConstrainter_Accept(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		c    Constrainter
+		want string
+	}{
+		{
+			name: "match_all",
+			c:    NewMatchAll(),
+			want: "match_all",
+		},
+		{
+			name: "endless",
+			c:    NewGreaterThan(MustParse("1")),
+			want: "endless(>1)",
+		},
+		{
+			name: "exact",
+			c:    NewExactConstraint(MustParse("1")),
+			want: "exact(1)",
+		},
+		{
+			name: "interval",
+			c:    MustNewInterval(MustParse("1"), MustParse("2"), true, false),
+			want: "interval(>=1 <2)",
+		},
+		{
+			name: "interval_zero",
+			c:    Interval{},
+			want: "match_all",
+		},
+		{
+			name: "or",
+			c:    MustParseConstraint("<1.0 || 1.5 || ^2.0"),
+			want: "or endless(<1-dev) exact(1.5) interval(>=2-dev <3-dev)",
+		},
+		{
+			name: "or_zero",
+			c:    Or{},
+			want: "match_none",
+		},
+		{
+			name: "match_none",
+			c:    NewMatchNone(),
+			want: "match_none",
+		},
+		{
+			name: "not_equal",
+			c:    NewNotEqual(MustParse("1")),
+			want: "not_equal(!=1)",
+		},
+		{
+			name: "all_of",
+			c:    AllOf{NewMatchAll(), AnyOf{NewExactConstraint(MustParse("1")), NewNotEqual(MustParse("2"))}},
+			want: "all_of match_all any_of exact(1) not_equal(!=2)",
+		},
+		{
+			name: "flagged",
+			c:    MustParseConstraint("^1.0@beta"),
+			want: "flagged(beta) interval(>=1-dev <2-dev)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &recordingVisitor{}
+			tt.c.Accept(r)
+
+			if got := strings.Join(r.visited, " "); got != tt.want {
+				t.Errorf("%q.Accept() visited %q, want %q", tt.c, got, tt.want)
+			}
+		})
+	}
+}
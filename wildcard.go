@@ -0,0 +1,38 @@
+package comver
+
+// NewWildcard returns a [CeilingFloorConstrainter] instance representing the
+// [wildcard version range] of the given [Version], e.g. '1.2.*' for '1.2'; or
+// return an error if the [Version] is a named dev branch.
+//
+// Same as composer, the numeric component after the last given one is the
+// wildcard, and pre-releases are included, e.g.:
+//   - '1.*' means '>=1.0.0.0-dev <2.0.0.0-dev'
+//   - '1.2.*' means '>=1.2.0.0-dev <1.3.0.0-dev'
+//   - '0.*' means '<1.0.0.0-dev'
+//
+// Modifiers and pre-releases of the given [Version] are ignored. For versions
+// not coming from [Parse], the number of numeric components in [Version.Short]
+// is used. Use [NewMatchAll] for '*'. Named dev branches, e.g. dev-master,
+// have no ranges, same as [NewHyphenRange].
+//
+// [wildcard version range]: https://getcomposer.org/doc/articles/versions.md#wildcard-version-range-
+func NewWildcard(v Version) (CeilingFloorConstrainter, error) { //nolint:ireturn
+	if v.isBranch() {
+		var nilC CeilingFloorConstrainter
+
+		return nilC, errBranchInterval
+	}
+
+	position := v.parts()
+
+	floor := NewGreaterThanOrEqualTo(v.truncate(position).lowestPreRelease())
+	if v.truncate(position).Compare(Version{}) == 0 {
+		// nothing is lower than '0.0.0.0-dev'
+		floor = NewMatchAll()
+	}
+
+	return And(
+		floor,
+		NewLessThan(v.bump(position).lowestPreRelease()),
+	)
+}
@@ -0,0 +1,22 @@
+package comver_test
+
+import (
+	"fmt"
+
+	"github.com/typisttech/comver"
+)
+
+func ExampleNewWildcard() {
+	major, _ := comver.NewWildcard(comver.MustParse("1"))
+	minor, _ := comver.NewWildcard(comver.MustParse("1.2"))
+	zero, _ := comver.NewWildcard(comver.MustParse("0"))
+
+	fmt.Println(major)
+	fmt.Println(minor)
+	fmt.Println(zero)
+
+	// Output:
+	// >=1-dev <2-dev
+	// >=1.2-dev <1.3-dev
+	// <1-dev
+}
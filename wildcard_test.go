@@ -0,0 +1,86 @@
+package comver
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewWildcard(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v    string
+		want string
+	}{
+		{"2", ">=2-dev <3-dev"},
+		{"20", ">=20-dev <21-dev"},
+		{"2.0", ">=2-dev <2.1-dev"},
+		{"2.2", ">=2.2-dev <2.3-dev"},
+		{"2.10", ">=2.10-dev <2.11-dev"},
+		{"2.1.3", ">=2.1.3-dev <2.1.4-dev"},
+		{"0", "<1-dev"},
+		{"0.0", "<0.1-dev"},
+		{"0.0.0", "<0.0.1-dev"},
+		{"0.1", ">=0.1-dev <0.2-dev"},
+		{"2.1-beta", ">=2.1-dev <2.2-dev"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewWildcard(MustParse(tt.v))
+			if err != nil {
+				t.Fatalf("NewWildcard(%v) error = %v", tt.v, err)
+			}
+
+			if gotString := got.String(); gotString != tt.want {
+				t.Errorf("NewWildcard(%q).String() = %q, want %q", tt.v, gotString, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewWildcard_zero(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		v    Version
+		want string
+	}{
+		{"zero", Version{}, "<1-dev"},
+		{"major", Version{major: 1}, ">=1-dev <2-dev"},
+		{"minor", Version{major: 1, minor: 2}, ">=1.2-dev <1.3-dev"},
+		{"patch", Version{major: 1, minor: 2, patch: 3}, ">=1.2.3-dev <1.2.4-dev"},
+		{"tweak", Version{major: 1, minor: 2, patch: 3, tweak: 4}, ">=1.2.3.4-dev <1.2.3.5-dev"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewWildcard(tt.v)
+			if err != nil {
+				t.Fatalf("NewWildcard(%v) error = %v", tt.v, err)
+			}
+
+			if gotString := got.String(); gotString != tt.want {
+				t.Errorf("NewWildcard(%v).String() = %q, want %q", tt.v, gotString, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewWildcard_branch(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"dev-master", "dev-1.x"} {
+		t.Run(v, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewWildcard(MustParse(v))
+			if !errors.Is(err, errBranchInterval) {
+				t.Errorf("NewWildcard(%q) = %v, error = %v, wantErr %v", v, got, err, errBranchInterval)
+			}
+		})
+	}
+}